@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"log"
 	"net/http"
+
+	"github.com/jeffswenson/sanity/pkg/html"
 )
 
 //go:embed stylesheet.css
@@ -15,7 +17,7 @@ func main() {
 		// TODO return 404 if the wrong url is queried
 		articles := generateArticles(40)
 		document := indexDocument(articles)	
-		_, err := w.Write(document.Render())
+		err := document.RenderTo(w, html.FlushAfterHead())
 		if err != nil {
 			// What should the error handling be here?
 			log.Fatal(err)
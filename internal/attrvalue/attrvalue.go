@@ -0,0 +1,26 @@
+// Package attrvalue formats the numeric attribute values accepted by the
+// generated constructors in pkg/attr, pkg/svg, and pkg/mathml.
+package attrvalue
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// Int constructs an attribute whose value is a valid integer, like
+// `colspan`.
+func Int(name string, value int) html.Node {
+	return html.NewAttribute(name, strconv.Itoa(value))
+}
+
+// Float constructs an attribute whose value is a valid floating-point
+// number, like `step`. NaN and infinities are not valid numbers.
+func Float(name string, value float64) html.Node {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return html.Invalid(fmt.Errorf("invalid number %v for attribute %q", value, name))
+	}
+	return html.NewAttribute(name, strconv.FormatFloat(value, 'f', -1, 64))
+}
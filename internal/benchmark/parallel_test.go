@@ -0,0 +1,127 @@
+package benchmark
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+type articleModel struct {
+	Name     string
+	Author   string
+	Link     string
+	Upvotes  int
+	Comments int
+}
+
+func generateArticles(count int) []articleModel {
+	articles := make([]articleModel, count)
+	for i := range articles {
+		articles[i] = articleModel{
+			Name:     fmt.Sprintf("article title %d", i),
+			Author:   fmt.Sprintf("author %d", i),
+			Link:     fmt.Sprintf("/article/%d", i),
+			Upvotes:  i * 13,
+			Comments: i * 3,
+		}
+	}
+	return articles
+}
+
+func articleView(article articleModel) html.Node {
+	return tag.Div(
+		attr.Class("article"),
+		tag.Div(
+			attr.Class("article-title-line"),
+			tag.A(
+				attr.Class("article-name"),
+				attr.HRef(article.Link),
+				html.InnerText(article.Name),
+			),
+		),
+		tag.Div(
+			attr.Class("article-subtitle-line"),
+			tag.Span(attr.Class("article-author"), html.InnerText(article.Author)),
+			tag.Span(html.InnerText(fmt.Sprintf("upvotes: %d", article.Upvotes))),
+			tag.Span(html.InnerText(fmt.Sprintf("comments: %d", article.Comments))),
+		),
+	)
+}
+
+var articleCounts = []int{1, 10, 40, 160, 640}
+
+func BenchmarkArticlesSerial(b *testing.B) {
+	for _, count := range articleCounts {
+		articles := generateArticles(count)
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			var buffer bytes.Buffer
+			for i := 0; i < b.N; i++ {
+				buffer.Reset()
+				node := tag.Main(html.ForEach(articles, articleView))
+				require.NoError(b, node.RenderTo(&buffer))
+			}
+		})
+	}
+}
+
+func BenchmarkArticlesParallel(b *testing.B) {
+	workers := runtime.GOMAXPROCS(0)
+	for _, count := range articleCounts {
+		articles := generateArticles(count)
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			var buffer bytes.Buffer
+			for i := 0; i < b.N; i++ {
+				buffer.Reset()
+				node := tag.Main(html.ForEachParallel(articles, articleView))
+				require.NoError(b, node.RenderTo(&buffer, html.Parallel(workers)))
+			}
+		})
+	}
+}
+
+// latencyWorkers is the pool size used by BenchmarkArticlesLatency. Items that
+// wait on I/O don't need a core while they wait, so the pool is larger than
+// the number of cores.
+const latencyWorkers = 8
+
+// BenchmarkArticlesLatency renders article cards whose view waits before
+// returning, like a view that loads data from another service. It measures
+// the latency and fan-out at which ForEachParallel beats ForEach even without
+// spare cores.
+func BenchmarkArticlesLatency(b *testing.B) {
+	for _, latency := range []time.Duration{0, time.Millisecond} {
+		for _, count := range []int{1, 2, 10, 40} {
+			articles := generateArticles(count)
+			view := func(article articleModel) html.Node {
+				if latency != 0 {
+					time.Sleep(latency)
+				}
+				return articleView(article)
+			}
+			name := fmt.Sprintf("%v/%d", latency, count)
+			b.Run("Serial/"+name, func(b *testing.B) {
+				var buffer bytes.Buffer
+				for i := 0; i < b.N; i++ {
+					buffer.Reset()
+					node := tag.Main(html.ForEachParallel(articles, view))
+					require.NoError(b, node.RenderTo(&buffer))
+				}
+			})
+			b.Run("Parallel/"+name, func(b *testing.B) {
+				var buffer bytes.Buffer
+				for i := 0; i < b.N; i++ {
+					buffer.Reset()
+					node := tag.Main(html.ForEachParallel(articles, view))
+					require.NoError(b, node.RenderTo(&buffer, html.Parallel(latencyWorkers)))
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Package elementpath builds the paths used to locate elements in error
+// messages, e.g. `html>body>div[2]>p`. It is shared by the validator, the
+// accessibility checker, and sanitytest so they describe locations the same
+// way.
+package elementpath
+
+import "strconv"
+
+// Segment returns the path segment for names[i], where names holds the names
+// of the element and its siblings in order. Elements that share their name
+// with a sibling are numbered starting from 1.
+func Segment(names []string, i int) string {
+	count, index := 0, 0
+	for j, name := range names {
+		if name == names[i] {
+			count++
+			if j == i {
+				index = count
+			}
+		}
+	}
+	if count == 1 {
+		return names[i]
+	}
+	return names[i] + "[" + strconv.Itoa(index) + "]"
+}
+
+// Join appends the segment to the parent's path.
+func Join(parent string, segment string) string {
+	if parent == "" {
+		return segment
+	}
+	return parent + ">" + segment
+}
@@ -0,0 +1,795 @@
+// Command specgen generates the tables in pkg/spec and the constructors in
+// pkg/tag, pkg/attr, pkg/event, pkg/typed, pkg/svg, and pkg/mathml from the
+// JSON files in pkg/spec. It is run by `go generate ./pkg/spec`.
+//
+// elements.json is a machine-readable copy of the element index in the WHATWG
+// HTML standard (https://html.spec.whatwg.org/multipage/indices.html) and the
+// list of obsolete elements. attributes.json is a copy of the attribute index.
+// Both are extended with the name and documentation of the Go constructor.
+// Entries without a constructor name only appear in pkg/spec. keywords.json
+// lists the keywords of enumerated attributes, like the values of `<input
+// type>` or the link types of `rel`. svg.json lists the elements and
+// attributes of SVG 2 (https://www.w3.org/TR/SVG2/) and mathml.json lists the
+// elements and attributes of MathML Core (https://w3c.github.io/mathml-core/).
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// element is one entry of elements.json.
+type element struct {
+	Name             string   `json:"name"`
+	Func             string   `json:"func"`
+	Description      string   `json:"description"`
+	Display          string   `json:"display"`
+	Void             bool     `json:"void"`
+	OptionalStartTag bool     `json:"optionalStartTag"`
+	OptionalEndTag   bool     `json:"optionalEndTag"`
+	Namespace        string   `json:"namespace"`
+	Obsolete         bool     `json:"obsolete"`
+	Deprecated       string   `json:"deprecated"`
+	Doc              []string `json:"doc"`
+}
+
+// attribute is one entry of attributes.json.
+type attribute struct {
+	Name        string   `json:"name"`
+	Func        string   `json:"func"`
+	Description string   `json:"description"`
+	Boolean     bool     `json:"boolean"`
+	URL         bool     `json:"url"`
+	Event       bool     `json:"event"`
+	Global      bool     `json:"global"`
+	Elements    []string `json:"elements"`
+	Keywords    []string `json:"keywords"`
+	TokenList   bool     `json:"tokenList"`
+	ValueType   string   `json:"valueType"`
+	Doc         []string `json:"doc"`
+}
+
+// foreign is the contents of svg.json or mathml.json, which list the elements
+// and attributes of a foreign namespace.
+type foreign struct {
+	Elements   []element   `json:"elements"`
+	Attributes []attribute `json:"attributes"`
+}
+
+// keyword is one entry of keywords.json. It is a set of keywords that is
+// generated as a string type with a constant for each keyword.
+type keyword struct {
+	Type       string   `json:"type"`
+	Attributes []string `json:"attributes"`
+	Doc        []string `json:"doc"`
+	Values     []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"values"`
+}
+
+func main() {
+	var elements []element
+	read("elements.json", &elements)
+	var attributes []attribute
+	read("attributes.json", &attributes)
+	var keywords []keyword
+	read("keywords.json", &keywords)
+
+	writeTables(elements, attributes, keywords)
+
+	var tags, voidTags []element
+	for _, e := range elements {
+		switch {
+		case e.Func == "":
+		case e.Void:
+			voidTags = append(voidTags, e)
+		default:
+			tags = append(tags, e)
+		}
+	}
+	writeTags("../tag/tags.go", "NewTag", tags)
+	writeTags("../tag/void_tag.go", "NewVoidTag", voidTags)
+
+	var attrs, boolAttrs, events []attribute
+	for _, a := range attributes {
+		switch {
+		case a.Func == "":
+		case a.Event:
+			events = append(events, a)
+		case a.Boolean:
+			boolAttrs = append(boolAttrs, a)
+		default:
+			attrs = append(attrs, a)
+		}
+	}
+	writeAttributes("../attr/attributes.go", attrs)
+	writeAttributes("../attr/bool_attributes.go", boolAttrs)
+	writeKeywords("../attr/keywords.go", keywords)
+	writeEvents("../event/events.go", events)
+	writeTyped(elements, append(attrs, boolAttrs...))
+
+	var svg foreign
+	read("svg.json", &svg)
+	writeForeign(svg, "svg", "http://www.w3.org/2000/svg")
+
+	var mathml foreign
+	read("mathml.json", &mathml)
+	writeForeign(mathml, "mathml", "http://www.w3.org/1998/Math/MathML")
+}
+
+// writeForeign writes the tables and constructors of a foreign namespace. The
+// tables are written to pkg/spec/<pkg>_tables.go as <pkg>Elements and
+// <pkg>Attributes and the constructors to pkg/<pkg>.
+func writeForeign(f foreign, pkg string, namespace string) {
+	for i := range f.Elements {
+		f.Elements[i].Namespace = namespace
+		f.Elements[i].Display = "inline"
+	}
+	source := "pkg/spec/" + pkg + ".json"
+
+	out := header(pkg+".json", "spec")
+	writeElementTable(&out, pkg+"Elements", f.Elements, f.Attributes)
+	out.WriteString("\n")
+	writeAttributeTable(&out, pkg+"Attributes", f.Attributes, nil)
+	write(pkg+"_tables.go", out)
+
+	out = header(source, pkg)
+	out.WriteString("import \"github.com/jeffswenson/sanity/pkg/html\"\n")
+	for _, e := range f.Elements {
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `<%s>` element.\n", e.Func, e.Name)
+		writeDoc(&out, wrap(e.Description+".", 77))
+		fmt.Fprintf(&out, "func %s(children ...html.Node) html.Node {\n", e.Func)
+		fmt.Fprintf(&out, "\treturn html.NewForeignTag(%q, children...)\n", e.Name)
+		out.WriteString("}\n")
+	}
+	write("../"+pkg+"/elements.go", out)
+
+	out = header(source, pkg)
+	writeImports(&out, f.Attributes)
+	for _, a := range f.Attributes {
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `%s` attribute.\n", a.Func, a.Name)
+		writeDoc(&out, wrap(a.Description+".", 77))
+		v, typed := valueTypes[a.ValueType]
+		switch {
+		case a.ValueType != "" && !typed:
+			log.Fatalf("attribute %q has unknown value type %q", a.Name, a.ValueType)
+		case a.Boolean:
+			fmt.Fprintf(&out, "func %s() html.Node {\n", a.Func)
+			fmt.Fprintf(&out, "\treturn html.NewBoolAttribute(%q)\n", a.Name)
+		default:
+			if typed {
+				writeDoc(&out, wrap(valueDoc(a, v), 77))
+			}
+			fmt.Fprintf(&out, "func %s(value string) html.Node {\n", a.Func)
+			fmt.Fprintf(&out, "\treturn html.NewAttribute(%q, value)\n", a.Name)
+		}
+		out.WriteString("}\n")
+		if typed {
+			writeValueFuncs(&out, a, v)
+		}
+	}
+	write("../"+pkg+"/attributes.go", out)
+}
+
+func read(path string, v any) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+}
+
+func writeTables(elements []element, attributes []attribute, keywords []keyword) {
+	// Attribute.Keywords is the union of the keyword sets of the attribute.
+	types := map[string]keyword{}
+	for _, k := range keywords {
+		types[k.Type] = k
+	}
+	attributeKeywords := map[string][]string{}
+	for _, a := range attributes {
+		seen := map[string]bool{}
+		for _, name := range a.Keywords {
+			k, ok := types[name]
+			if !ok {
+				log.Fatalf("attribute %q has unknown keywords %q", a.Name, name)
+			}
+			for _, v := range k.Values {
+				if !seen[v.Value] {
+					seen[v.Value] = true
+					attributeKeywords[a.Name] = append(attributeKeywords[a.Name], v.Value)
+				}
+			}
+		}
+	}
+
+	out := header("elements.json, attributes.json, and keywords.json", "spec")
+	writeElementTable(&out, "elements", elements, attributes)
+	out.WriteString("\n")
+	writeAttributeTable(&out, "attributes", attributes, attributeKeywords)
+	write("tables.go", out)
+}
+
+// writeElementTable writes the elements as a slice of spec.Element.
+func writeElementTable(out *bytes.Buffer, name string, elements []element, attributes []attribute) {
+	// Element.Attributes is the inverse of attribute.Elements.
+	elementAttributes := map[string][]string{}
+	known := map[string]bool{}
+	for _, e := range elements {
+		known[e.Name] = true
+	}
+	for _, a := range attributes {
+		for _, name := range a.Elements {
+			if !known[name] {
+				log.Fatalf("attribute %q applies to unknown element <%s>", a.Name, name)
+			}
+			elementAttributes[name] = append(elementAttributes[name], a.Name)
+		}
+	}
+
+	fmt.Fprintf(out, "var %s = []Element{\n", name)
+	for _, e := range elements {
+		fields := []string{
+			fmt.Sprintf("Name: %q", e.Name),
+			fmt.Sprintf("Description: %q", e.Description),
+			"Display: " + displayConstant(e),
+		}
+		if e.Void {
+			fields = append(fields, "Void: true")
+		}
+		if e.OptionalStartTag {
+			fields = append(fields, "OptionalStartTag: true")
+		}
+		if e.OptionalEndTag {
+			fields = append(fields, "OptionalEndTag: true")
+		}
+		if e.Namespace != "" {
+			fields = append(fields, fmt.Sprintf("Namespace: %q", e.Namespace))
+		}
+		if e.Obsolete {
+			fields = append(fields, "Obsolete: true")
+		}
+		if names := elementAttributes[e.Name]; len(names) != 0 {
+			sort.Strings(names)
+			fields = append(fields, "Attributes: "+stringSlice(names))
+		}
+		fmt.Fprintf(out, "\t{\n\t\t%s,\n\t},\n", strings.Join(fields, ",\n\t\t"))
+	}
+	out.WriteString("}\n")
+}
+
+// writeAttributeTable writes the attributes as a slice of spec.Attribute.
+func writeAttributeTable(out *bytes.Buffer, name string, attributes []attribute, keywords map[string][]string) {
+	fmt.Fprintf(out, "var %s = []Attribute{\n", name)
+	for _, a := range attributes {
+		fields := []string{
+			fmt.Sprintf("Name: %q", a.Name),
+			fmt.Sprintf("Description: %q", a.Description),
+		}
+		if a.Boolean {
+			fields = append(fields, "Boolean: true")
+		}
+		if a.URL {
+			fields = append(fields, "URL: true")
+		}
+		if a.Event {
+			fields = append(fields, "Event: true")
+		}
+		if a.Global {
+			fields = append(fields, "Global: true")
+		}
+		if len(a.Elements) != 0 {
+			fields = append(fields, "Elements: "+stringSlice(a.Elements))
+		}
+		if values := keywords[a.Name]; len(values) != 0 {
+			fields = append(fields, "Keywords: "+stringSlice(values))
+		}
+		if a.TokenList {
+			fields = append(fields, "TokenList: true")
+		}
+		fmt.Fprintf(out, "\t{\n\t\t%s,\n\t},\n", strings.Join(fields, ",\n\t\t"))
+	}
+	out.WriteString("}\n")
+}
+
+func displayConstant(e element) string {
+	switch e.Display {
+	case "inline":
+		return "Inline"
+	case "block":
+		return "Block"
+	case "none":
+		return "None"
+	}
+	log.Fatalf("element <%s> has unknown display %q", e.Name, e.Display)
+	return ""
+}
+
+func stringSlice(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+func writeTags(path string, constructor string, elements []element) {
+	out := header("pkg/spec/elements.json", "tag")
+	out.WriteString("import \"github.com/jeffswenson/sanity/pkg/html\"\n")
+	for _, e := range elements {
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `<%s>` tag.\n", e.Func, e.Name)
+		writeDoc(&out, e.Doc)
+		if e.Obsolete {
+			writeDeprecated(&out, fmt.Sprintf("<%s> is obsolete in the HTML standard. %s", e.Name, e.Deprecated))
+		}
+		fmt.Fprintf(&out, "func %s(children ...html.Node) html.Node {\n", e.Func)
+		fmt.Fprintf(&out, "\treturn html.%s(%q, children...)\n", constructor, e.Name)
+		out.WriteString("}\n")
+	}
+	write(path, out)
+}
+
+// valueType describes the typed constructors of an attribute whose value is
+// a number, a date, or a duration. The attribute's own constructor takes a
+// string, so it can be passed around as a func(string) html.Node, and each
+// typed value has a separately named constructor, like WidthInt. The numeric
+// helpers live in internal/attrvalue, so pkg/attr, pkg/svg, and pkg/mathml
+// share them.
+type valueType struct {
+	variants []valueVariant
+	// doc is appended to the documentation of the string constructor.
+	doc string
+}
+
+// valueVariant is a typed constructor of an attribute. The helper formats
+// the value.
+type valueVariant struct {
+	suffix string
+	param  string
+	helper string
+	// doc describes how the value is formatted. It completes the sentence
+	// "WidthInt constructs the `width` attribute from ".
+	doc string
+}
+
+var (
+	intVariant = valueVariant{
+		suffix: "Int",
+		param:  "int",
+		helper: "attrvalue.Int",
+		doc:    "an int.",
+	}
+	floatVariant = valueVariant{
+		suffix: "Float",
+		param:  "float64",
+		helper: "attrvalue.Float",
+		doc:    "a float64. NaN and infinities are not valid numbers and render as nothing.",
+	}
+)
+
+var valueTypes = map[string]valueType{
+	"integer": {variants: []valueVariant{intVariant}},
+	"number":  {variants: []valueVariant{intVariant, floatVariant}},
+	"limit": {
+		variants: []valueVariant{intVariant, floatVariant},
+		doc:      "The limits of date and time inputs are constructed by the function for the input's type, like MinDate or MaxWeek.",
+	},
+	"datetime": {
+		variants: []valueVariant{{
+			suffix: "At",
+			param:  "time.Time",
+			helper: "globalDateTime",
+			doc:    "a time.Time, formatted as a global date and time, like 2006-01-02T15:04:05Z.",
+		}, {
+			suffix: "Duration",
+			param:  "time.Duration",
+			helper: "duration",
+			doc:    "a time.Duration, formatted as a duration, like PT1H30M, which is only valid on <time>. Negative durations are not valid and render as nothing.",
+		}},
+	},
+}
+
+// valueDoc points the string constructor of an attribute with typed values
+// at its typed constructors.
+func valueDoc(a attribute, v valueType) string {
+	var functions, params []string
+	for _, variant := range v.variants {
+		functions = append(functions, a.Func+variant.suffix)
+		params = append(params, article(variant.param))
+	}
+	doc := fmt.Sprintf("Use %s to construct the attribute from %s.", strings.Join(functions, " or "), strings.Join(params, " or "))
+	if v.doc != "" {
+		doc += " " + v.doc
+	}
+	return doc
+}
+
+// article prefixes a type name with "a" or "an".
+func article(name string) string {
+	if strings.IndexByte("aeiou", name[0]) != -1 {
+		return "an " + name
+	}
+	return "a " + name
+}
+
+// writeValueFuncs writes the typed constructors of an attribute, like
+// WidthInt.
+func writeValueFuncs(out *bytes.Buffer, a attribute, v valueType) {
+	for _, variant := range v.variants {
+		function := a.Func + variant.suffix
+		out.WriteString("\n")
+		for _, line := range wrap(fmt.Sprintf("%s constructs the `%s` attribute from %s", function, a.Name, variant.doc), 77) {
+			out.WriteString("// " + line + "\n")
+		}
+		fmt.Fprintf(out, "func %s(value %s) html.Node {\n", function, variant.param)
+		fmt.Fprintf(out, "\treturn %s(%q, value)\n", variant.helper, a.Name)
+		out.WriteString("}\n")
+	}
+}
+
+// writeImports writes the imports of a file of attribute constructors. The
+// html package is always imported and the time and attrvalue packages are
+// imported if a constructor uses them.
+func writeImports(out *bytes.Buffer, attributes []attribute) {
+	var usesTime, usesAttrValue bool
+	for _, a := range attributes {
+		for _, variant := range valueTypes[a.ValueType].variants {
+			usesTime = usesTime || strings.HasPrefix(variant.param, "time.")
+			usesAttrValue = usesAttrValue || strings.HasPrefix(variant.helper, "attrvalue.")
+		}
+	}
+	if !usesTime && !usesAttrValue {
+		out.WriteString("import \"github.com/jeffswenson/sanity/pkg/html\"\n")
+		return
+	}
+	out.WriteString("import (\n")
+	if usesTime {
+		out.WriteString("\t\"time\"\n\n")
+	}
+	if usesAttrValue {
+		out.WriteString("\t\"github.com/jeffswenson/sanity/internal/attrvalue\"\n")
+	}
+	out.WriteString("\t\"github.com/jeffswenson/sanity/pkg/html\"\n")
+	out.WriteString(")\n")
+}
+
+func writeAttributes(path string, attributes []attribute) {
+	out := header("pkg/spec/attributes.json", "attr")
+	writeImports(&out, attributes)
+	for _, a := range attributes {
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `%s` attribute.\n", a.Func, a.Name)
+		if len(a.Keywords) != 0 {
+			writeDoc(&out, wrap(keywordDoc(a), 77))
+		}
+		v, typed := valueTypes[a.ValueType]
+		if a.ValueType != "" && !typed {
+			log.Fatalf("attribute %q has unknown value type %q", a.Name, a.ValueType)
+		}
+		if typed {
+			writeDoc(&out, wrap(valueDoc(a, v), 77))
+		}
+		writeDoc(&out, a.Doc)
+		switch {
+		case a.Boolean:
+			fmt.Fprintf(&out, "func %s() html.Node {\n", a.Func)
+			fmt.Fprintf(&out, "\treturn html.NewBoolAttribute(%q)\n", a.Name)
+		default:
+			fmt.Fprintf(&out, "func %s(value string) html.Node {\n", a.Func)
+			fmt.Fprintf(&out, "\treturn html.NewAttribute(%q, value)\n", a.Name)
+		}
+		out.WriteString("}\n")
+		if typed {
+			writeValueFuncs(&out, a, v)
+		}
+		if len(a.Keywords) != 0 {
+			out.WriteString("\n")
+			writeKeywordFunc(&out, a, "", keywordFunc(a), a.Func, "html.Node", func(param string) string {
+				if a.TokenList {
+					return fmt.Sprintf("html.NewAttribute(%q, join(%s))", a.Name, param)
+				}
+				return fmt.Sprintf("html.NewAttribute(%q, string(%s))", a.Name, param)
+			})
+		}
+		if a.Boolean {
+			out.WriteString("\n")
+			for _, line := range wrap(fmt.Sprintf("%sIf constructs the `%s` attribute if condition is true. Otherwise it renders nothing.", a.Func, a.Name), 77) {
+				out.WriteString("// " + line + "\n")
+			}
+			fmt.Fprintf(&out, "func %sIf(condition bool) html.Node {\n", a.Func)
+			out.WriteString("\tif !condition {\n\t\treturn html.Node{}\n\t}\n")
+			fmt.Fprintf(&out, "\treturn html.NewBoolAttribute(%q)\n", a.Name)
+			out.WriteString("}\n")
+		}
+	}
+	write(path, out)
+}
+
+// keywordFunc is the name of the constructor that accepts an enumerated
+// attribute's keyword constants, like RelKeywords.
+func keywordFunc(a attribute) string {
+	if a.TokenList {
+		return a.Func + "Keywords"
+	}
+	return a.Func + "Keyword"
+}
+
+// keywordTypes lists the keyword types of an enumerated attribute, like
+// "InputTypeValue, ButtonTypeValue or ListTypeValue".
+func keywordTypes(a attribute, pkg string) string {
+	types := pkg + strings.Join(a.Keywords, ", "+pkg)
+	if i := strings.LastIndex(types, ", "); i != -1 {
+		types = types[:i] + " or " + types[i+2:]
+	}
+	return types
+}
+
+// keywordDoc points the string constructor of an enumerated attribute at the
+// constructor for its keyword constants.
+func keywordDoc(a attribute) string {
+	return fmt.Sprintf("Use %s to construct the attribute from the %s constants.", keywordFunc(a), keywordTypes(a, ""))
+}
+
+// writeKeywordFunc writes the constructor that accepts an enumerated
+// attribute's keyword constants. It accepts the attribute's keyword types,
+// but not plain strings or the keyword types of other attributes. result is
+// the constructor's return type and body formats its return value from the
+// parameter name. see is the constructor the doc comment refers to.
+func writeKeywordFunc(out *bytes.Buffer, a attribute, pkg string, function string, see string, result string, body func(param string) string) {
+	if a.TokenList {
+		doc := fmt.Sprintf("%s constructs the `%s` attribute from %s constants, which are joined by spaces. See %s.", function, a.Name, keywordTypes(a, pkg), see)
+		for _, line := range wrap(doc, 77) {
+			out.WriteString("// " + line + "\n")
+		}
+		if len(a.Keywords) == 1 {
+			fmt.Fprintf(out, "func %s(values ...%s%s) %s {\n", function, pkg, a.Keywords[0], result)
+		} else {
+			fmt.Fprintf(out, "func %s[T %s](values ...T) %s {\n", function, keywordConstraint(a, pkg), result)
+		}
+		fmt.Fprintf(out, "\treturn %s\n", body("values"))
+	} else {
+		doc := fmt.Sprintf("%s constructs the `%s` attribute from one of the %s constants. See %s.", function, a.Name, keywordTypes(a, pkg), see)
+		for _, line := range wrap(doc, 77) {
+			out.WriteString("// " + line + "\n")
+		}
+		if len(a.Keywords) == 1 {
+			fmt.Fprintf(out, "func %s(value %s%s) %s {\n", function, pkg, a.Keywords[0], result)
+		} else {
+			fmt.Fprintf(out, "func %s[T %s](value T) %s {\n", function, keywordConstraint(a, pkg), result)
+		}
+		fmt.Fprintf(out, "\treturn %s\n", body("value"))
+	}
+	out.WriteString("}\n")
+}
+
+// keywordConstraint is the type constraint of the keyword constructor of an
+// attribute with several keyword types, like `type`.
+func keywordConstraint(a attribute, pkg string) string {
+	var constraint []string
+	for _, k := range a.Keywords {
+		constraint = append(constraint, pkg+k)
+	}
+	return strings.Join(constraint, " | ")
+}
+
+func writeKeywords(path string, keywords []keyword) {
+	out := header("pkg/spec/keywords.json", "attr")
+	for _, k := range keywords {
+		out.WriteString("\n")
+		for _, line := range k.Doc {
+			out.WriteString("// " + line + "\n")
+		}
+		fmt.Fprintf(&out, "type %s string\n\n", k.Type)
+		out.WriteString("const (\n")
+		for _, v := range k.Values {
+			fmt.Fprintf(&out, "\t%s %s = %q\n", v.Name, k.Type, v.Value)
+		}
+		out.WriteString(")\n")
+	}
+	write(path, out)
+}
+
+// writeTyped writes pkg/typed. Every element has an option interface, which
+// is implemented by the attributes that apply to the element. The attribute
+// constructors wrap the constructors in pkg/attr.
+func writeTyped(elements []element, attributes []attribute) {
+	var typed []element
+	// names are the identifiers attribute constructors must not use.
+	names := map[string]bool{"Attributes": true, "Child": true, "Children": true, "Text": true}
+	for _, e := range elements {
+		if e.Func != "" && !e.Obsolete {
+			typed = append(typed, e)
+			names[e.Func] = true
+		}
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+
+	out := header("pkg/spec/elements.json", "typed")
+	out.WriteString("import \"github.com/jeffswenson/sanity/pkg/html\"\n")
+	for _, e := range typed {
+		doc := fmt.Sprintf("%sOption is a global attribute or an attribute of `<%s>`.", e.Func, e.Name)
+		if !e.Void {
+			doc += " Content is passed with Children or Text."
+		}
+		out.WriteString("\n")
+		for _, line := range wrap(doc, 77) {
+			out.WriteString("// " + line + "\n")
+		}
+		fmt.Fprintf(&out, "type %sOption interface {\n", e.Func)
+		out.WriteString("\tnode() html.Node\n")
+		fmt.Fprintf(&out, "\t%sOption()\n", e.Name)
+		out.WriteString("}\n")
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `<%s>` tag. See tag.%s.\n", e.Func, e.Name, e.Func)
+		fmt.Fprintf(&out, "func %s(options ...%sOption) html.Node {\n", e.Func, e.Func)
+		if e.Void {
+			fmt.Fprintf(&out, "\treturn html.NewVoidTag(%q, nodes(options)...)\n", e.Name)
+		} else {
+			fmt.Fprintf(&out, "\treturn html.NewTag(%q, nodes(options)...)\n", e.Name)
+		}
+		out.WriteString("}\n")
+	}
+	out.WriteString("\n")
+	for _, e := range typed {
+		if !e.Void {
+			fmt.Fprintf(&out, "func (Child) %sOption() {}\n", e.Name)
+		}
+	}
+	out.WriteString("\n")
+	for _, e := range typed {
+		fmt.Fprintf(&out, "func (GlobalAttribute) %sOption() {}\n", e.Name)
+	}
+	write("../typed/elements.go", out)
+
+	out = header("pkg/spec/attributes.json", "typed")
+	out.WriteString("import (\n")
+	out.WriteString("\t\"time\"\n\n")
+	out.WriteString("\t\"github.com/jeffswenson/sanity/pkg/attr\"\n")
+	out.WriteString(")\n")
+	for _, a := range attributes {
+		// Attributes that share a name with an element or a function in
+		// typed.go get an Attr suffix, like attr.DataAttr in pkg/attr.
+		function := a.Func
+		if names[function] {
+			function += "Attr"
+		}
+		result := "GlobalAttribute"
+		if !a.Global {
+			result = a.Func + "Attribute"
+			var applies []string
+			for _, name := range a.Elements {
+				applies = append(applies, "<"+name+">")
+			}
+			fmt.Fprintf(&out, "\n")
+			for _, line := range wrap(fmt.Sprintf("%s is the `%s` attribute, which applies to %s.", result, a.Name, list(applies)), 77) {
+				out.WriteString("// " + line + "\n")
+			}
+			fmt.Fprintf(&out, "type %s struct{ option }\n\n", result)
+			for _, name := range a.Elements {
+				for _, e := range typed {
+					if e.Name == name {
+						fmt.Fprintf(&out, "func (%s) %sOption() {}\n", result, e.Name)
+					}
+				}
+			}
+		}
+
+		fmt.Fprintf(&out, "\n// %s constructs the `%s` attribute. See attr.%s.\n", function, a.Name, a.Func)
+		v := valueTypes[a.ValueType]
+		switch {
+		case a.Boolean:
+			fmt.Fprintf(&out, "func %s() %s {\n", function, result)
+			fmt.Fprintf(&out, "\treturn %s{option{attr.%s()}}\n", result, a.Func)
+			out.WriteString("}\n")
+			fmt.Fprintf(&out, "\n// %sIf constructs the `%s` attribute if condition is true. See attr.%sIf.\n", function, a.Name, a.Func)
+			fmt.Fprintf(&out, "func %sIf(condition bool) %s {\n", function, result)
+			fmt.Fprintf(&out, "\treturn %s{option{attr.%sIf(condition)}}\n", result, a.Func)
+		default:
+			fmt.Fprintf(&out, "func %s(value string) %s {\n", function, result)
+			fmt.Fprintf(&out, "\treturn %s{option{attr.%s(value)}}\n", result, a.Func)
+		}
+		out.WriteString("}\n")
+		for _, variant := range v.variants {
+			out.WriteString("\n")
+			for _, line := range wrap(fmt.Sprintf("%s%s constructs the `%s` attribute from %s. See attr.%s%s.", function, variant.suffix, a.Name, article(variant.param), a.Func, variant.suffix), 77) {
+				out.WriteString("// " + line + "\n")
+			}
+			fmt.Fprintf(&out, "func %s%s(value %s) %s {\n", function, variant.suffix, variant.param, result)
+			fmt.Fprintf(&out, "\treturn %s{option{attr.%s%s(value)}}\n", result, a.Func, variant.suffix)
+			out.WriteString("}\n")
+		}
+		if len(a.Keywords) != 0 {
+			out.WriteString("\n")
+			writeKeywordFunc(&out, a, "attr.", keywordFunc(a), "attr."+keywordFunc(a), result, func(param string) string {
+				if a.TokenList {
+					param += "..."
+				}
+				return fmt.Sprintf("%s{option{attr.%s(%s)}}", result, keywordFunc(a), param)
+			})
+		}
+	}
+	write("../typed/attributes.go", out)
+}
+
+// list joins items into an English list, like "a, b, and c".
+func list(items []string) string {
+	switch len(items) {
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " and " + items[1]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + ", and " + items[len(items)-1]
+}
+
+func writeEvents(path string, attributes []attribute) {
+	out := header("pkg/spec/attributes.json", "event")
+	out.WriteString("import (\n")
+	out.WriteString("\t\"github.com/jeffswenson/sanity/pkg/html\"\n")
+	out.WriteString("\t\"github.com/jeffswenson/sanity/pkg/js\"\n")
+	out.WriteString(")\n")
+	for _, a := range attributes {
+		fmt.Fprintf(&out, "\n// %s constructs an html.Node for the `%s` event handler attribute.\n", a.Func, a.Name)
+		writeDoc(&out, a.Doc)
+		fmt.Fprintf(&out, "func %s(script js.Script) html.Node {\n", a.Func)
+		fmt.Fprintf(&out, "\treturn handler(%q, script)\n", a.Name)
+		out.WriteString("}\n")
+	}
+	write(path, out)
+}
+
+func header(source string, pkg string) bytes.Buffer {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by internal/specgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	return out
+}
+
+func writeDoc(out *bytes.Buffer, doc []string) {
+	out.WriteString("//\n")
+	for _, line := range doc {
+		out.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+	}
+}
+
+func writeDeprecated(out *bytes.Buffer, notice string) {
+	out.WriteString("//\n")
+	for _, line := range wrap("Deprecated: "+notice, 77) {
+		out.WriteString("// " + line + "\n")
+	}
+}
+
+// wrap splits text into lines of at most width bytes.
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	return append(lines, line)
+}
+
+func write(path string, out bytes.Buffer) {
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("formatting %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
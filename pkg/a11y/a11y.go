@@ -0,0 +1,145 @@
+// Package a11y checks rendered Node trees for common accessibility problems,
+// like images without alt text or form controls without labels. Check is
+// intended for tests and Middleware logs the problems found on each page
+// while developing.
+package a11y
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jeffswenson/sanity/internal/elementpath"
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// Severity describes how badly an issue affects users of assistive
+// technology.
+type Severity int
+
+const (
+	// SeverityWarning issues make the page harder to use.
+	SeverityWarning Severity = iota
+	// SeverityError issues make content inaccessible.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "Severity(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// Issue is a violation of one of the accessibility rules.
+type Issue struct {
+	// Rule is the name of the rule that was violated, e.g. "image-alt".
+	Rule     string
+	Severity Severity
+	// Path locates the offending element, e.g. `html>body>div[2]>img`.
+	Path    string
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s [%s %s]", i.Path, i.Message, i.Severity, i.Rule)
+}
+
+// Check walks the node and returns the accessibility issues it finds. The
+// rules are:
+//   - html-lang: the <html> element must have a lang attribute
+//   - image-alt: <img> elements must have an alt attribute. Use alt="" for
+//     decorative images.
+//   - label: form controls must have a <label>, either wrapping the control
+//     or referencing it with the for attribute, or an aria-label,
+//     aria-labelledby, or title attribute
+//   - button-name: buttons must have text or an aria-label
+//   - link-name: links must have text or an aria-label
+//   - heading-order: heading levels must not skip, e.g. an <h3> must not
+//     follow an <h1>
+//
+// Lazy nodes are evaluated once with context.Background(). Failed Try nodes
+// are skipped.
+//
+// Example Usage:
+//
+//	for _, issue := range a11y.Check(indexDocument(articles)) {
+//		t.Error(issue)
+//	}
+func Check(node html.Node) []Issue {
+	evaluated, _ := html.Evaluate(context.Background(), node)
+	return check(evaluated)
+}
+
+// CheckContext is like Check, but evaluates lazy nodes with the context.
+func CheckContext(ctx context.Context, node html.Node) []Issue {
+	evaluated, _ := html.Evaluate(ctx, node)
+	return check(evaluated)
+}
+
+// check inspects a node returned by html.Evaluate, so walking the tree more
+// than once does not call lazy nodes again.
+func check(node html.Node) []Issue {
+	c := checker{labelFor: map[string]bool{}}
+	roots := node.Children()
+	c.collectLabels(roots)
+	c.walk("", roots, false)
+	return c.issues
+}
+
+type checker struct {
+	issues []Issue
+	// labelFor contains the for attribute of every label in the tree.
+	labelFor map[string]bool
+	// heading is the level of the last heading visited.
+	heading int
+}
+
+func (c *checker) report(rule string, severity Severity, path string, format string, args ...any) {
+	c.issues = append(c.issues, Issue{
+		Rule:     rule,
+		Severity: severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) collectLabels(nodes []html.Node) {
+	for _, node := range nodes {
+		if strings.EqualFold(node.TagName(), "label") {
+			if target, ok := node.Attribute("for"); ok {
+				c.labelFor[target] = true
+			}
+		}
+		c.collectLabels(node.Children())
+	}
+}
+
+// walk checks each element in the list of siblings. inLabel is true if the
+// siblings are inside of a <label>.
+func (c *checker) walk(parent string, siblings []html.Node, inLabel bool) {
+	for i, node := range siblings {
+		name := strings.ToLower(node.TagName())
+		if name == "" || strings.HasPrefix(name, "!") {
+			continue
+		}
+		path := elementpath.Join(parent, segment(siblings, i))
+		c.check(path, name, node, inLabel)
+		c.walk(path, node.Children(), inLabel || name == "label")
+	}
+}
+
+// segment returns the path segment for the element. Elements that share their
+// name with a sibling are numbered starting from 1.
+func segment(siblings []html.Node, child int) string {
+	names := make([]string, len(siblings))
+	for i, sibling := range siblings {
+		names[i] = strings.ToLower(sibling.TagName())
+	}
+	return elementpath.Segment(names, child)
+}
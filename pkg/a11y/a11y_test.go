@@ -0,0 +1,77 @@
+package a11y
+
+import (
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func issueStrings(node html.Node) []string {
+	var result []string
+	for _, issue := range Check(node) {
+		result = append(result, issue.String())
+	}
+	return result
+}
+
+func TestCheckAccessibleDocument(t *testing.T) {
+	node := html.Document(
+		attr.Lang("en"),
+		tag.Body(
+			tag.H1(html.InnerText("title")),
+			tag.H2(html.InnerText("section")),
+			tag.Img(attr.Src("/logo.png"), attr.Alt("")),
+			tag.Form(
+				tag.Label(attr.For("email"), html.InnerText("Email")),
+				tag.Input(attr.Id("email"), attr.Type("email")),
+				tag.Label(html.InnerText("Name"), tag.Input(attr.Type("text"))),
+				tag.TextArea(html.NewAttribute("aria-label", "comment")),
+				tag.Input(attr.Type("hidden"), attr.Name("csrf")),
+				tag.Button(tag.Img(attr.Src("/send.png"), attr.Alt("send"))),
+			),
+			tag.H2(html.InnerText("another section")),
+			tag.A(attr.HRef("/"), tag.Span(html.InnerText("home"))),
+		),
+	)
+	require.Empty(t, issueStrings(node))
+}
+
+func TestCheck(t *testing.T) {
+	node := html.Document(
+		tag.Body(
+			tag.H1(html.InnerText("title")),
+			tag.H3(html.InnerText("skipped")),
+			tag.Div(tag.Img(attr.Src("/cat.png"))),
+			tag.Div(
+				tag.Label(attr.For("other"), html.InnerText("Other")),
+				tag.Input(attr.Id("email")),
+				tag.Select(),
+				tag.Button(html.InnerText("  ")),
+				tag.A(attr.HRef("/"), tag.Img(attr.Src("/home.png"))),
+			),
+		),
+	)
+	require.Equal(t, []string{
+		"html: <html> is missing a lang attribute, so screen readers can't pick a pronunciation [error html-lang]",
+		"html>body>h3: <h3> follows <h1>, heading levels should only increase by one [warning heading-order]",
+		`html>body>div[1]>img: <img> is missing an alt attribute, use alt="" for decorative images [error image-alt]`,
+		"html>body>div[2]>input: <input> does not have a <label>, aria-label, or aria-labelledby [error label]",
+		"html>body>div[2]>select: <select> does not have a <label>, aria-label, or aria-labelledby [error label]",
+		"html>body>div[2]>button: <button> does not have text, an aria-label, or aria-labelledby [error button-name]",
+		"html>body>div[2]>a: <a> does not have text, an aria-label, or aria-labelledby [error link-name]",
+		`html>body>div[2]>a>img: <img> is missing an alt attribute, use alt="" for decorative images [error image-alt]`,
+	}, issueStrings(node))
+}
+
+func TestCheckFragment(t *testing.T) {
+	issues := Check(html.Combine(tag.Img(), tag.Img(attr.Alt("dog"))))
+	require.Equal(t, []Issue{{
+		Rule:     "image-alt",
+		Severity: SeverityError,
+		Path:     "img[1]",
+		Message:  `<img> is missing an alt attribute, use alt="" for decorative images`,
+	}}, issues)
+}
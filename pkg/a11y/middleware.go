@@ -0,0 +1,46 @@
+package a11y
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// View renders the page for a request.
+type View func(r *http.Request) html.Node
+
+// Middleware checks every page rendered by a view and logs the issues it
+// finds along with the request's path. Lazy nodes are evaluated once with the
+// request's context and the view returns the evaluated page, so they are not
+// called again when the page is rendered. The evaluated page is rendered
+// without ForEachParallel concurrency or the Cached node cache, so the
+// middleware is intended for development builds.
+//
+// If evaluating the page fails, the issues are not checked and the view
+// returns the original page, so rendering it reports the error.
+//
+// Example Usage:
+//
+//	checked := a11y.Middleware(log.Default())
+//	index := checked(func(r *http.Request) html.Node {
+//		return indexDocument(loadArticles(r.Context()))
+//	})
+//	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//		index(r).RenderTo(w)
+//	})
+func Middleware(logger *log.Logger) func(next View) View {
+	return func(next View) View {
+		return func(r *http.Request) html.Node {
+			node := next(r)
+			evaluated, err := html.Evaluate(r.Context(), node)
+			if err != nil {
+				return node
+			}
+			for _, issue := range check(evaluated) {
+				logger.Printf("a11y: %s %s", r.URL.Path, issue)
+			}
+			return evaluated
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package a11y
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	var logs bytes.Buffer
+	logger := log.New(&logs, "", 0)
+	view := Middleware(logger)(func(r *http.Request) html.Node {
+		return tag.Div(tag.Img(attr.Src("/cat.png")))
+	})
+
+	node := view(httptest.NewRequest("GET", "/cats", nil))
+	require.Equal(t, `<div><img src="/cat.png"></div>`, node.String())
+	require.Equal(t,
+		"a11y: /cats div>img: <img> is missing an alt attribute, use alt=\"\" for decorative images [error image-alt]\n",
+		logs.String())
+}
+
+type userKey struct{}
+
+func TestMiddlewareContext(t *testing.T) {
+	var logs bytes.Buffer
+	logger := log.New(&logs, "", 0)
+	calls := 0
+	view := Middleware(logger)(func(r *http.Request) html.Node {
+		return tag.Div(html.Func(func(ctx context.Context) html.Node {
+			calls++
+			return tag.Button(html.InnerText("Save " + ctx.Value(userKey{}).(string)))
+		}))
+	})
+
+	r := httptest.NewRequest("GET", "/profile", nil)
+	r = r.WithContext(context.WithValue(r.Context(), userKey{}, "jeff"))
+	node := view(r)
+	require.Equal(t, `<div><button>Save jeff</button></div>`, node.String())
+	require.Equal(t, 1, calls)
+	require.Empty(t, logs.String())
+}
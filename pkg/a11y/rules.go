@@ -0,0 +1,97 @@
+package a11y
+
+import (
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+func (c *checker) check(path string, name string, node html.Node, inLabel bool) {
+	switch name {
+	case "html":
+		if lang, _ := node.Attribute("lang"); strings.TrimSpace(lang) == "" {
+			c.report("html-lang", SeverityError, path,
+				"<html> is missing a lang attribute, so screen readers can't pick a pronunciation")
+		}
+	case "img":
+		if _, ok := node.Attribute("alt"); !ok {
+			c.report("image-alt", SeverityError, path,
+				`<img> is missing an alt attribute, use alt="" for decorative images`)
+		}
+	case "input", "select", "textarea":
+		if isLabelable(node) && !inLabel && !c.hasLabel(node) {
+			c.report("label", SeverityError, path,
+				"<%s> does not have a <label>, aria-label, or aria-labelledby", name)
+		}
+	case "button":
+		if !hasAccessibleName(node) {
+			c.report("button-name", SeverityError, path,
+				"<button> does not have text, an aria-label, or aria-labelledby")
+		}
+	case "a":
+		if _, ok := node.Attribute("href"); ok && !hasAccessibleName(node) {
+			c.report("link-name", SeverityError, path,
+				"<a> does not have text, an aria-label, or aria-labelledby")
+		}
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(name[1] - '0')
+		if c.heading != 0 && level > c.heading+1 {
+			c.report("heading-order", SeverityWarning, path,
+				"<%s> follows <h%d>, heading levels should only increase by one", name, c.heading)
+		}
+		c.heading = level
+	}
+}
+
+// isLabelable returns false for form controls that are labeled by their value
+// or are not shown to the user.
+func isLabelable(node html.Node) bool {
+	if !strings.EqualFold(node.TagName(), "input") {
+		return true
+	}
+	inputType, _ := node.Attribute("type")
+	switch strings.ToLower(inputType) {
+	case "hidden", "submit", "reset", "button", "image":
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *checker) hasLabel(node html.Node) bool {
+	if id, ok := node.Attribute("id"); ok && c.labelFor[id] {
+		return true
+	}
+	return hasAriaLabel(node) || hasAttribute(node, "title")
+}
+
+// hasAccessibleName returns true if the element has text, an image with alt
+// text, or an aria label.
+func hasAccessibleName(node html.Node) bool {
+	if hasAriaLabel(node) || hasAttribute(node, "title") {
+		return true
+	}
+	if strings.TrimSpace(node.Text()) != "" {
+		return true
+	}
+	for _, child := range node.Children() {
+		if strings.EqualFold(child.TagName(), "img") && hasAttribute(child, "alt") {
+			return true
+		}
+		if child.TagName() != "" && hasAccessibleName(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAriaLabel(node html.Node) bool {
+	return hasAttribute(node, "aria-label") || hasAttribute(node, "aria-labelledby")
+}
+
+// hasAttribute returns true if the node has the attribute with a non-empty
+// value.
+func hasAttribute(node html.Node, name string) bool {
+	value, _ := node.Attribute(name)
+	return strings.TrimSpace(value) != ""
+}
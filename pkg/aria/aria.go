@@ -0,0 +1,151 @@
+// Package aria contains a function for every WAI-ARIA state and property and
+// for the `role` attribute. ARIA attributes describe the purpose and state of
+// custom widgets to assistive technologies like screen readers.
+//
+// Prefer native HTML elements over ARIA: a <button> needs no role="button".
+// Enumerated states take typed values. The typed constants, like
+// aria.CurrentPage, document the allowed values, but untyped string constants
+// convert to the named types, so a typo like aria.Current("pgae") still
+// compiles.
+//
+// Example Usage:
+//
+//	tag.Button(
+//		aria.Expanded(false),
+//		aria.Controls("menu"),
+//		html.InnerText("Options"),
+//	)
+package aria
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// Tristate is the value of aria-checked and aria-pressed.
+type Tristate string
+
+const (
+	TristateFalse Tristate = "false"
+	TristateTrue  Tristate = "true"
+	// TristateMixed is used for a checkbox that controls a group of
+	// checkboxes that are partially checked.
+	TristateMixed Tristate = "mixed"
+)
+
+// AutoCompleteValue is the value of aria-autocomplete.
+type AutoCompleteValue string
+
+const (
+	AutoCompleteNone   AutoCompleteValue = "none"
+	AutoCompleteInline AutoCompleteValue = "inline"
+	AutoCompleteList   AutoCompleteValue = "list"
+	AutoCompleteBoth   AutoCompleteValue = "both"
+)
+
+// CurrentValue is the value of aria-current.
+type CurrentValue string
+
+const (
+	CurrentFalse    CurrentValue = "false"
+	CurrentTrue     CurrentValue = "true"
+	CurrentPage     CurrentValue = "page"
+	CurrentStep     CurrentValue = "step"
+	CurrentLocation CurrentValue = "location"
+	CurrentDate     CurrentValue = "date"
+	CurrentTime     CurrentValue = "time"
+)
+
+// HasPopupValue is the value of aria-haspopup.
+type HasPopupValue string
+
+const (
+	HasPopupFalse   HasPopupValue = "false"
+	HasPopupTrue    HasPopupValue = "true"
+	HasPopupMenu    HasPopupValue = "menu"
+	HasPopupListBox HasPopupValue = "listbox"
+	HasPopupTree    HasPopupValue = "tree"
+	HasPopupGrid    HasPopupValue = "grid"
+	HasPopupDialog  HasPopupValue = "dialog"
+)
+
+// InvalidValue is the value of aria-invalid.
+type InvalidValue string
+
+const (
+	InvalidFalse    InvalidValue = "false"
+	InvalidTrue     InvalidValue = "true"
+	InvalidGrammar  InvalidValue = "grammar"
+	InvalidSpelling InvalidValue = "spelling"
+)
+
+// LiveValue is the value of aria-live.
+type LiveValue string
+
+const (
+	LiveOff       LiveValue = "off"
+	LivePolite    LiveValue = "polite"
+	LiveAssertive LiveValue = "assertive"
+)
+
+// OrientationValue is the value of aria-orientation.
+type OrientationValue string
+
+const (
+	OrientationHorizontal OrientationValue = "horizontal"
+	OrientationVertical   OrientationValue = "vertical"
+)
+
+// RelevantValue is one of the tokens in the value of aria-relevant.
+type RelevantValue string
+
+const (
+	RelevantAdditions RelevantValue = "additions"
+	RelevantRemovals  RelevantValue = "removals"
+	RelevantText      RelevantValue = "text"
+	RelevantAll       RelevantValue = "all"
+)
+
+// SortValue is the value of aria-sort.
+type SortValue string
+
+const (
+	SortNone       SortValue = "none"
+	SortAscending  SortValue = "ascending"
+	SortDescending SortValue = "descending"
+	SortOther      SortValue = "other"
+)
+
+// Attr constructs an html.Node for the `aria-*` attribute with the given
+// name. The name is given without the `aria-` prefix. Attr is an escape hatch
+// for ARIA attributes that are newer than this package; prefer the typed
+// functions. If the name contains anything other than lower case ASCII
+// letters, Attr returns a node that renders as nothing. See html.Invalid.
+//
+// Example Usage:
+// aria.Attr("braillelabel", "Start") renders as aria-braillelabel="Start"
+func Attr(name string, value string) html.Node {
+	if name == "" || strings.Trim(name, "abcdefghijklmnopqrstuvwxyz") != "" {
+		return html.Invalid(fmt.Errorf("aria: invalid ARIA attribute name %q", name))
+	}
+	return html.NewAttribute("aria-"+name, value)
+}
+
+func boolean(name string, value bool) html.Node {
+	return html.NewAttribute(name, strconv.FormatBool(value))
+}
+
+func integer(name string, value int) html.Node {
+	return html.NewAttribute(name, strconv.Itoa(value))
+}
+
+func number(name string, value float64) html.Node {
+	return html.NewAttribute(name, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+func idList(name string, ids []string) html.Node {
+	return html.NewAttribute(name, strings.Join(ids, " "))
+}
@@ -0,0 +1,81 @@
+package aria
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributes(t *testing.T) {
+	tests := []struct {
+		node   html.Node
+		result string
+	}{
+		{ActiveDescendant("option-2"), `aria-activedescendant="option-2"`},
+		{Atomic(true), `aria-atomic="true"`},
+		{AutoComplete(AutoCompleteList), `aria-autocomplete="list"`},
+		{Busy(false), `aria-busy="false"`},
+		{Checked(TristateMixed), `aria-checked="mixed"`},
+		{ColCount(-1), `aria-colcount="-1"`},
+		{ColIndex(7), `aria-colindex="7"`},
+		{ColSpan(2), `aria-colspan="2"`},
+		{Controls("menu"), `aria-controls="menu"`},
+		{Current(CurrentPage), `aria-current="page"`},
+		{DescribedBy("hint", "error"), `aria-describedby="hint error"`},
+		{Description("Deletes the draft"), `aria-description="Deletes the draft"`},
+		{Details("chart-data"), `aria-details="chart-data"`},
+		{Disabled(true), `aria-disabled="true"`},
+		{ErrorMessage("email-error"), `aria-errormessage="email-error"`},
+		{Expanded(false), `aria-expanded="false"`},
+		{FlowTo("step-2"), `aria-flowto="step-2"`},
+		{HasPopup(HasPopupListBox), `aria-haspopup="listbox"`},
+		{Hidden(true), `aria-hidden="true"`},
+		{Invalid(InvalidSpelling), `aria-invalid="spelling"`},
+		{KeyShortcuts("Control+S", "Meta+S"), `aria-keyshortcuts="Control+S Meta+S"`},
+		{Label(`Close "dialog"`), `aria-label="Close &#34;dialog&#34;"`},
+		{LabelledBy("billing"), `aria-labelledby="billing"`},
+		{Level(3), `aria-level="3"`},
+		{Live(LiveAssertive), `aria-live="assertive"`},
+		{Modal(true), `aria-modal="true"`},
+		{MultiLine(true), `aria-multiline="true"`},
+		{MultiSelectable(false), `aria-multiselectable="false"`},
+		{Orientation(OrientationVertical), `aria-orientation="vertical"`},
+		{Owns("a", "b"), `aria-owns="a b"`},
+		{Placeholder("Search"), `aria-placeholder="Search"`},
+		{PosInSet(11), `aria-posinset="11"`},
+		{Pressed(TristateTrue), `aria-pressed="true"`},
+		{ReadOnly(true), `aria-readonly="true"`},
+		{Relevant(RelevantAdditions, RelevantRemovals), `aria-relevant="additions removals"`},
+		{Required(true), `aria-required="true"`},
+		{RoleDescription("slide"), `aria-roledescription="slide"`},
+		{RowCount(1000), `aria-rowcount="1000"`},
+		{RowIndex(51), `aria-rowindex="51"`},
+		{RowSpan(3), `aria-rowspan="3"`},
+		{Selected(true), `aria-selected="true"`},
+		{SetSize(250), `aria-setsize="250"`},
+		{Sort(SortDescending), `aria-sort="descending"`},
+		{ValueMax(100), `aria-valuemax="100"`},
+		{ValueMin(-2.5), `aria-valuemin="-2.5"`},
+		{ValueNow(0.25), `aria-valuenow="0.25"`},
+		{ValueText("Tuesday"), `aria-valuetext="Tuesday"`},
+		{Role(RoleSwitch), `role="switch"`},
+		{Role(RoleSearchBox, RoleTextBox), `role="searchbox textbox"`},
+		{Attr("braillelabel", "Start"), `aria-braillelabel="Start"`},
+	}
+	for _, tc := range tests {
+		require.Equal(t, "<div "+tc.result+"></div>", tag.Div(tc.node).String())
+	}
+}
+
+func TestAttrInvalidName(t *testing.T) {
+	for _, name := range []string{"", "Label", "label x", "aria-label", "x=1"} {
+		require.Equal(t, "<div></div>", tag.Div(Attr(name, "v")).String(), name)
+	}
+
+	var buffer strings.Builder
+	err := tag.Div(Attr("Label", "v")).RenderTo(&buffer, html.Strict())
+	require.EqualError(t, err, `html: strict: aria: invalid ARIA attribute name "Label"`)
+}
@@ -0,0 +1,531 @@
+package aria
+
+import (
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// ActiveDescendant constructs an html.Node for the `aria-activedescendant`
+// attribute.
+//
+// `aria-activedescendant` identifies the child that is active when a
+// composite widget, like a combobox or listbox, keeps focus on itself instead
+// of moving focus to its children.
+//
+// Example Usage:
+// <ul role="listbox" tabindex="0" aria-activedescendant="option-2">...</ul>
+func ActiveDescendant(id string) html.Node {
+	return html.NewAttribute("aria-activedescendant", id)
+}
+
+// Atomic constructs an html.Node for the `aria-atomic` attribute.
+//
+// `aria-atomic` controls whether assistive technologies announce the whole
+// live region or only the nodes that changed.
+//
+// Example Usage:
+// <div aria-live="polite" aria-atomic="true">Score: 3 - 2</div>
+func Atomic(value bool) html.Node {
+	return boolean("aria-atomic", value)
+}
+
+// AutoComplete constructs an html.Node for the `aria-autocomplete` attribute.
+//
+// `aria-autocomplete` describes how a text box or combobox suggests values
+// while the user types.
+//
+// Example Usage:
+// <input role="combobox" aria-autocomplete="list" aria-controls="cities">
+func AutoComplete(value AutoCompleteValue) html.Node {
+	return html.NewAttribute("aria-autocomplete", string(value))
+}
+
+// Busy constructs an html.Node for the `aria-busy` attribute.
+//
+// `aria-busy` tells assistive technologies to wait before announcing changes
+// to an element that is still being updated.
+//
+// Example Usage:
+// <section aria-busy="true">Loading results...</section>
+func Busy(value bool) html.Node {
+	return boolean("aria-busy", value)
+}
+
+// Checked constructs an html.Node for the `aria-checked` attribute.
+//
+// `aria-checked` is the state of a custom checkbox, radio button, switch, or
+// menu item. Use TristateMixed for a checkbox that controls a partially
+// checked group.
+//
+// Example Usage:
+// <div role="checkbox" tabindex="0" aria-checked="mixed">Select all</div>
+func Checked(value Tristate) html.Node {
+	return html.NewAttribute("aria-checked", string(value))
+}
+
+// ColCount constructs an html.Node for the `aria-colcount` attribute.
+//
+// `aria-colcount` is the number of columns in a table or grid when only some
+// of the columns are in the DOM. Use -1 if the number is unknown.
+//
+// Example Usage:
+// <div role="grid" aria-colcount="24">...</div>
+func ColCount(value int) html.Node {
+	return integer("aria-colcount", value)
+}
+
+// ColIndex constructs an html.Node for the `aria-colindex` attribute.
+//
+// `aria-colindex` is the one based position of a cell or row in the full
+// table when only some of the columns are in the DOM.
+//
+// Example Usage:
+// <div role="gridcell" aria-colindex="7">Tuesday</div>
+func ColIndex(value int) html.Node {
+	return integer("aria-colindex", value)
+}
+
+// ColSpan constructs an html.Node for the `aria-colspan` attribute.
+//
+// `aria-colspan` is the number of columns spanned by a cell in a custom table
+// or grid.
+//
+// Example Usage:
+// <div role="cell" aria-colspan="2">Total</div>
+func ColSpan(value int) html.Node {
+	return integer("aria-colspan", value)
+}
+
+// Controls constructs an html.Node for the `aria-controls` attribute.
+//
+// `aria-controls` lists the ids of the elements whose content or presence is
+// controlled by the element, like the panel opened by a tab.
+//
+// Example Usage:
+// <button aria-expanded="false" aria-controls="menu">Options</button>
+func Controls(ids ...string) html.Node {
+	return idList("aria-controls", ids)
+}
+
+// Current constructs an html.Node for the `aria-current` attribute.
+//
+// `aria-current` marks the element that represents the current item in a set,
+// like the link to the current page in a navigation menu.
+//
+// Example Usage:
+// <a href="/pricing" aria-current="page">Pricing</a>
+func Current(value CurrentValue) html.Node {
+	return html.NewAttribute("aria-current", string(value))
+}
+
+// DescribedBy constructs an html.Node for the `aria-describedby` attribute.
+//
+// `aria-describedby` lists the ids of the elements that describe the element.
+// Screen readers announce the description after the element's name.
+//
+// Example Usage:
+// <input id="password" aria-describedby="password-hint">
+// <p id="password-hint">Use at least 12 characters.</p>
+func DescribedBy(ids ...string) html.Node {
+	return idList("aria-describedby", ids)
+}
+
+// Description constructs an html.Node for the `aria-description` attribute.
+//
+// `aria-description` is a description of the element for when there is no
+// visible description to reference with aria-describedby.
+//
+// Example Usage:
+// <button aria-description="Deletes the draft permanently">Delete</button>
+func Description(value string) html.Node {
+	return html.NewAttribute("aria-description", value)
+}
+
+// Details constructs an html.Node for the `aria-details` attribute.
+//
+// `aria-details` lists the ids of the elements that contain extended details
+// about the element, like a long description of a chart.
+//
+// Example Usage:
+// <img src="chart.png" alt="Revenue by quarter" aria-details="chart-data">
+func Details(ids ...string) html.Node {
+	return idList("aria-details", ids)
+}
+
+// Disabled constructs an html.Node for the `aria-disabled` attribute.
+//
+// `aria-disabled` marks a custom widget as disabled. Unlike the `disabled`
+// attribute, it does not remove the element from the tab order.
+//
+// Example Usage:
+// <div role="button" tabindex="0" aria-disabled="true">Save</div>
+func Disabled(value bool) html.Node {
+	return boolean("aria-disabled", value)
+}
+
+// ErrorMessage constructs an html.Node for the `aria-errormessage` attribute.
+//
+// `aria-errormessage` lists the ids of the elements that explain why the
+// element's value is invalid. It is only announced when aria-invalid is set.
+//
+// Example Usage:
+// <input aria-invalid="true" aria-errormessage="email-error">
+// <p id="email-error">Enter an email address like name@example.com.</p>
+func ErrorMessage(ids ...string) html.Node {
+	return idList("aria-errormessage", ids)
+}
+
+// Expanded constructs an html.Node for the `aria-expanded` attribute.
+//
+// `aria-expanded` tells assistive technologies whether the element, or the
+// element it controls, is expanded or collapsed.
+//
+// Example Usage:
+// <button aria-expanded="true" aria-controls="faq-1">What is sanity?</button>
+func Expanded(value bool) html.Node {
+	return boolean("aria-expanded", value)
+}
+
+// FlowTo constructs an html.Node for the `aria-flowto` attribute.
+//
+// `aria-flowto` lists the ids of the elements that come next in an alternate
+// reading order.
+//
+// Example Usage:
+// <section id="step-1" aria-flowto="step-2">...</section>
+func FlowTo(ids ...string) html.Node {
+	return idList("aria-flowto", ids)
+}
+
+// HasPopup constructs an html.Node for the `aria-haspopup` attribute.
+//
+// `aria-haspopup` tells assistive technologies that the element opens a popup
+// and what kind of popup it opens.
+//
+// Example Usage:
+// <button aria-haspopup="menu" aria-controls="actions">Actions</button>
+func HasPopup(value HasPopupValue) html.Node {
+	return html.NewAttribute("aria-haspopup", string(value))
+}
+
+// Hidden constructs an html.Node for the `aria-hidden` attribute.
+//
+// `aria-hidden` removes an element and its children from the accessibility
+// tree, like a decorative icon. Never hide focusable elements.
+//
+// Example Usage:
+// <button><svg aria-hidden="true">...</svg> Download</button>
+func Hidden(value bool) html.Node {
+	return boolean("aria-hidden", value)
+}
+
+// Invalid constructs an html.Node for the `aria-invalid` attribute.
+//
+// `aria-invalid` marks a form control whose value failed validation.
+//
+// Example Usage:
+// <input name="email" aria-invalid="true" aria-errormessage="email-error">
+func Invalid(value InvalidValue) html.Node {
+	return html.NewAttribute("aria-invalid", string(value))
+}
+
+// KeyShortcuts constructs an html.Node for the `aria-keyshortcuts`
+// attribute.
+//
+// `aria-keyshortcuts` lists the keyboard shortcuts that activate or focus the
+// element. Each shortcut is a list of keys joined by "+".
+//
+// Example Usage:
+// <button aria-keyshortcuts="Control+S">Save</button>
+func KeyShortcuts(shortcuts ...string) html.Node {
+	return html.NewAttribute("aria-keyshortcuts", strings.Join(shortcuts, " "))
+}
+
+// Label constructs an html.Node for the `aria-label` attribute.
+//
+// `aria-label` is the accessible name of an element that has no visible text
+// label, like a button that only contains an icon.
+//
+// Example Usage:
+// <button aria-label="Close"><svg>...</svg></button>
+func Label(value string) html.Node {
+	return html.NewAttribute("aria-label", value)
+}
+
+// LabelledBy constructs an html.Node for the `aria-labelledby` attribute.
+//
+// `aria-labelledby` lists the ids of the elements whose text is the
+// accessible name of the element. It takes precedence over aria-label.
+//
+// Example Usage:
+// <h2 id="billing">Billing</h2>
+// <section aria-labelledby="billing">...</section>
+func LabelledBy(ids ...string) html.Node {
+	return idList("aria-labelledby", ids)
+}
+
+// Level constructs an html.Node for the `aria-level` attribute.
+//
+// `aria-level` is the one based level of a heading or of an item in a tree.
+//
+// Example Usage:
+// <div role="heading" aria-level="3">Shipping</div>
+func Level(value int) html.Node {
+	return integer("aria-level", value)
+}
+
+// Live constructs an html.Node for the `aria-live` attribute.
+//
+// `aria-live` marks a region whose updates should be announced. Polite
+// updates wait until the user is idle and assertive updates interrupt them.
+//
+// Example Usage:
+// <div aria-live="polite">3 items in your cart</div>
+func Live(value LiveValue) html.Node {
+	return html.NewAttribute("aria-live", string(value))
+}
+
+// Modal constructs an html.Node for the `aria-modal` attribute.
+//
+// `aria-modal` tells assistive technologies that the content outside of a
+// dialog is inert while the dialog is open.
+//
+// Example Usage:
+// <div role="dialog" aria-modal="true" aria-labelledby="dialog-title">...</div>
+func Modal(value bool) html.Node {
+	return boolean("aria-modal", value)
+}
+
+// MultiLine constructs an html.Node for the `aria-multiline` attribute.
+//
+// `aria-multiline` tells assistive technologies whether a custom text box
+// accepts multiple lines of input.
+//
+// Example Usage:
+// <div role="textbox" contenteditable="true" aria-multiline="true"></div>
+func MultiLine(value bool) html.Node {
+	return boolean("aria-multiline", value)
+}
+
+// MultiSelectable constructs an html.Node for the `aria-multiselectable`
+// attribute.
+//
+// `aria-multiselectable` tells assistive technologies whether the user may
+// select more than one item in a listbox, grid, tree, or tablist.
+//
+// Example Usage:
+// <ul role="listbox" aria-multiselectable="true">...</ul>
+func MultiSelectable(value bool) html.Node {
+	return boolean("aria-multiselectable", value)
+}
+
+// Orientation constructs an html.Node for the `aria-orientation` attribute.
+//
+// `aria-orientation` tells assistive technologies whether a slider,
+// scrollbar, separator, toolbar, or list is horizontal or vertical.
+//
+// Example Usage:
+// <div role="toolbar" aria-orientation="vertical">...</div>
+func Orientation(value OrientationValue) html.Node {
+	return html.NewAttribute("aria-orientation", string(value))
+}
+
+// Owns constructs an html.Node for the `aria-owns` attribute.
+//
+// `aria-owns` lists the ids of elements that are children of the element in
+// the accessibility tree even though they are elsewhere in the DOM.
+//
+// Example Usage:
+// <div role="menu" aria-owns="submenu-item">...</div>
+func Owns(ids ...string) html.Node {
+	return idList("aria-owns", ids)
+}
+
+// Placeholder constructs an html.Node for the `aria-placeholder` attribute.
+//
+// `aria-placeholder` is the hint shown in a custom text box when it is empty.
+// Native inputs should use the `placeholder` attribute instead.
+//
+// Example Usage:
+// <div role="textbox" contenteditable="true" aria-placeholder="Search"></div>
+func Placeholder(value string) html.Node {
+	return html.NewAttribute("aria-placeholder", value)
+}
+
+// PosInSet constructs an html.Node for the `aria-posinset` attribute.
+//
+// `aria-posinset` is the one based position of an item in a set when only
+// some of the items are in the DOM.
+//
+// Example Usage:
+// <article aria-posinset="11" aria-setsize="250">...</article>
+func PosInSet(value int) html.Node {
+	return integer("aria-posinset", value)
+}
+
+// Pressed constructs an html.Node for the `aria-pressed` attribute.
+//
+// `aria-pressed` turns a button into a toggle button and describes whether
+// it is pressed.
+//
+// Example Usage:
+// <button aria-pressed="true">Bold</button>
+func Pressed(value Tristate) html.Node {
+	return html.NewAttribute("aria-pressed", string(value))
+}
+
+// ReadOnly constructs an html.Node for the `aria-readonly` attribute.
+//
+// `aria-readonly` marks a custom widget whose value can be read but not
+// changed.
+//
+// Example Usage:
+// <div role="spinbutton" aria-readonly="true" aria-valuenow="4">4</div>
+func ReadOnly(value bool) html.Node {
+	return boolean("aria-readonly", value)
+}
+
+// Relevant constructs an html.Node for the `aria-relevant` attribute.
+//
+// `aria-relevant` lists the kinds of changes to a live region that should be
+// announced. The default is additions and text.
+//
+// Example Usage:
+// <ul aria-live="polite" aria-relevant="additions removals">...</ul>
+func Relevant(values ...RelevantValue) html.Node {
+	tokens := make([]string, len(values))
+	for i, value := range values {
+		tokens[i] = string(value)
+	}
+	return html.NewAttribute("aria-relevant", strings.Join(tokens, " "))
+}
+
+// Required constructs an html.Node for the `aria-required` attribute.
+//
+// `aria-required` marks a custom form widget that must have a value before
+// the form is submitted.
+//
+// Example Usage:
+// <div role="combobox" aria-required="true">...</div>
+func Required(value bool) html.Node {
+	return boolean("aria-required", value)
+}
+
+// RoleDescription constructs an html.Node for the `aria-roledescription`
+// attribute.
+//
+// `aria-roledescription` is a human readable description of the element's
+// role, like "slide" for a group in a carousel.
+//
+// Example Usage:
+// <section role="group" aria-roledescription="slide" aria-label="1 of 5">...</section>
+func RoleDescription(value string) html.Node {
+	return html.NewAttribute("aria-roledescription", value)
+}
+
+// RowCount constructs an html.Node for the `aria-rowcount` attribute.
+//
+// `aria-rowcount` is the number of rows in a table or grid when only some of
+// the rows are in the DOM. Use -1 if the number is unknown.
+//
+// Example Usage:
+// <table aria-rowcount="1000">...</table>
+func RowCount(value int) html.Node {
+	return integer("aria-rowcount", value)
+}
+
+// RowIndex constructs an html.Node for the `aria-rowindex` attribute.
+//
+// `aria-rowindex` is the one based position of a row or cell in the full
+// table when only some of the rows are in the DOM.
+//
+// Example Usage:
+// <tr aria-rowindex="51">...</tr>
+func RowIndex(value int) html.Node {
+	return integer("aria-rowindex", value)
+}
+
+// RowSpan constructs an html.Node for the `aria-rowspan` attribute.
+//
+// `aria-rowspan` is the number of rows spanned by a cell in a custom table or
+// grid.
+//
+// Example Usage:
+// <div role="cell" aria-rowspan="3">Morning</div>
+func RowSpan(value int) html.Node {
+	return integer("aria-rowspan", value)
+}
+
+// Selected constructs an html.Node for the `aria-selected` attribute.
+//
+// `aria-selected` is the selection state of a tab, option, row, or grid cell.
+//
+// Example Usage:
+// <button role="tab" aria-selected="true" aria-controls="panel-1">Profile</button>
+func Selected(value bool) html.Node {
+	return boolean("aria-selected", value)
+}
+
+// SetSize constructs an html.Node for the `aria-setsize` attribute.
+//
+// `aria-setsize` is the number of items in a set when only some of the items
+// are in the DOM. Use -1 if the number is unknown.
+//
+// Example Usage:
+// <article aria-posinset="11" aria-setsize="-1">...</article>
+func SetSize(value int) html.Node {
+	return integer("aria-setsize", value)
+}
+
+// Sort constructs an html.Node for the `aria-sort` attribute.
+//
+// `aria-sort` tells assistive technologies how a table or grid is sorted by
+// a column header.
+//
+// Example Usage:
+// <th aria-sort="ascending"><button>Name</button></th>
+func Sort(value SortValue) html.Node {
+	return html.NewAttribute("aria-sort", string(value))
+}
+
+// ValueMax constructs an html.Node for the `aria-valuemax` attribute.
+//
+// `aria-valuemax` is the maximum value of a range widget like a slider.
+//
+// Example Usage:
+// <div role="slider" aria-valuemin="0" aria-valuemax="100" aria-valuenow="25"></div>
+func ValueMax(value float64) html.Node {
+	return number("aria-valuemax", value)
+}
+
+// ValueMin constructs an html.Node for the `aria-valuemin` attribute.
+//
+// `aria-valuemin` is the minimum value of a range widget like a slider.
+//
+// Example Usage:
+// <div role="slider" aria-valuemin="0" aria-valuemax="100" aria-valuenow="25"></div>
+func ValueMin(value float64) html.Node {
+	return number("aria-valuemin", value)
+}
+
+// ValueNow constructs an html.Node for the `aria-valuenow` attribute.
+//
+// `aria-valuenow` is the current value of a range widget like a slider.
+//
+// Example Usage:
+// <div role="progressbar" aria-valuemin="0" aria-valuemax="1" aria-valuenow="0.5"></div>
+func ValueNow(value float64) html.Node {
+	return number("aria-valuenow", value)
+}
+
+// ValueText constructs an html.Node for the `aria-valuetext` attribute.
+//
+// `aria-valuetext` is a human readable version of aria-valuenow, for values
+// like "Tuesday" that are not meaningful as numbers.
+//
+// Example Usage:
+// <div role="slider" aria-valuenow="2" aria-valuetext="Tuesday"></div>
+func ValueText(value string) html.Node {
+	return html.NewAttribute("aria-valuetext", value)
+}
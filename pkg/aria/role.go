@@ -0,0 +1,114 @@
+package aria
+
+import (
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// RoleName is a WAI-ARIA role.
+type RoleName string
+
+// The roles defined by WAI-ARIA 1.2. Abstract roles, like "widget", are not
+// included because authors must not use them.
+const (
+	RoleAlert            RoleName = "alert"
+	RoleAlertDialog      RoleName = "alertdialog"
+	RoleApplication      RoleName = "application"
+	RoleArticle          RoleName = "article"
+	RoleBanner           RoleName = "banner"
+	RoleBlockquote       RoleName = "blockquote"
+	RoleButton           RoleName = "button"
+	RoleCaption          RoleName = "caption"
+	RoleCell             RoleName = "cell"
+	RoleCheckbox         RoleName = "checkbox"
+	RoleCode             RoleName = "code"
+	RoleColumnHeader     RoleName = "columnheader"
+	RoleComboBox         RoleName = "combobox"
+	RoleComplementary    RoleName = "complementary"
+	RoleContentInfo      RoleName = "contentinfo"
+	RoleDefinition       RoleName = "definition"
+	RoleDeletion         RoleName = "deletion"
+	RoleDialog           RoleName = "dialog"
+	RoleDocument         RoleName = "document"
+	RoleEmphasis         RoleName = "emphasis"
+	RoleFeed             RoleName = "feed"
+	RoleFigure           RoleName = "figure"
+	RoleForm             RoleName = "form"
+	RoleGeneric          RoleName = "generic"
+	RoleGrid             RoleName = "grid"
+	RoleGridCell         RoleName = "gridcell"
+	RoleGroup            RoleName = "group"
+	RoleHeading          RoleName = "heading"
+	RoleImg              RoleName = "img"
+	RoleInsertion        RoleName = "insertion"
+	RoleLink             RoleName = "link"
+	RoleList             RoleName = "list"
+	RoleListBox          RoleName = "listbox"
+	RoleListItem         RoleName = "listitem"
+	RoleLog              RoleName = "log"
+	RoleMain             RoleName = "main"
+	RoleMarquee          RoleName = "marquee"
+	RoleMath             RoleName = "math"
+	RoleMenu             RoleName = "menu"
+	RoleMenuBar          RoleName = "menubar"
+	RoleMenuItem         RoleName = "menuitem"
+	RoleMenuItemCheckbox RoleName = "menuitemcheckbox"
+	RoleMenuItemRadio    RoleName = "menuitemradio"
+	RoleMeter            RoleName = "meter"
+	RoleNavigation       RoleName = "navigation"
+	RoleNone             RoleName = "none"
+	RoleNote             RoleName = "note"
+	RoleOption           RoleName = "option"
+	RoleParagraph        RoleName = "paragraph"
+	RolePresentation     RoleName = "presentation"
+	RoleProgressBar      RoleName = "progressbar"
+	RoleRadio            RoleName = "radio"
+	RoleRadioGroup       RoleName = "radiogroup"
+	RoleRegion           RoleName = "region"
+	RoleRow              RoleName = "row"
+	RoleRowGroup         RoleName = "rowgroup"
+	RoleRowHeader        RoleName = "rowheader"
+	RoleScrollBar        RoleName = "scrollbar"
+	RoleSearch           RoleName = "search"
+	RoleSearchBox        RoleName = "searchbox"
+	RoleSeparator        RoleName = "separator"
+	RoleSlider           RoleName = "slider"
+	RoleSpinButton       RoleName = "spinbutton"
+	RoleStatus           RoleName = "status"
+	RoleStrong           RoleName = "strong"
+	RoleSubscript        RoleName = "subscript"
+	RoleSuperscript      RoleName = "superscript"
+	RoleSwitch           RoleName = "switch"
+	RoleTab              RoleName = "tab"
+	RoleTable            RoleName = "table"
+	RoleTabList          RoleName = "tablist"
+	RoleTabPanel         RoleName = "tabpanel"
+	RoleTerm             RoleName = "term"
+	RoleTextBox          RoleName = "textbox"
+	RoleTime             RoleName = "time"
+	RoleTimer            RoleName = "timer"
+	RoleToolbar          RoleName = "toolbar"
+	RoleTooltip          RoleName = "tooltip"
+	RoleTree             RoleName = "tree"
+	RoleTreeGrid         RoleName = "treegrid"
+	RoleTreeItem         RoleName = "treeitem"
+)
+
+// Role constructs an html.Node for the `role` attribute.
+//
+// The `role` attribute tells assistive technologies what kind of widget or
+// landmark an element is. Roles after the first are fallbacks for browsers
+// that don't support the first role. Prefer native elements, like <button>
+// or <nav>, which have the right role without the attribute.
+//
+// Example Usage:
+// <div role="tablist" aria-label="Settings">...</div>
+// <div role="switch" aria-checked="false" tabindex="0">Dark mode</div>
+func Role(roles ...RoleName) html.Node {
+	tokens := make([]string, len(roles))
+	for i, role := range roles {
+		tokens[i] = string(role)
+	}
+	return html.NewAttribute("role", strings.Join(tokens, " "))
+}
@@ -1,6 +1,13 @@
+// Code generated by internal/specgen from pkg/spec/attributes.json. DO NOT EDIT.
+
 package attr
 
-import "github.com/jeffswenson/sanity/pkg/html"
+import (
+	"time"
+
+	"github.com/jeffswenson/sanity/internal/attrvalue"
+	"github.com/jeffswenson/sanity/pkg/html"
+)
 
 // Abbr constructs an html.Node for the `abbr` attribute.
 //
@@ -125,6 +132,8 @@ func Alt(value string) html.Node {
 
 // As constructs an html.Node for the `as` attribute.
 //
+// Use AsKeyword to construct the attribute from the AsValue constants.
+//
 // `as` is used to specify the expected media type or format of a linked resource
 // in an HTML document. It is primarily used in the `link` and `script` tags to
 // inform the browser how to handle or interpret the resource. This attribute helps
@@ -140,8 +149,17 @@ func As(value string) html.Node {
 	return html.NewAttribute("as", value)
 }
 
+// AsKeyword constructs the `as` attribute from one of the AsValue constants.
+// See As.
+func AsKeyword(value AsValue) html.Node {
+	return html.NewAttribute("as", string(value))
+}
+
 // AutoCapitalize constructs an html.Node for the `autocapitalize` attribute.
 //
+// Use AutoCapitalizeKeyword to construct the attribute from the
+// AutoCapitalizeValue constants.
+//
 // `autocapitalize` is used to specify whether or not text input in an HTML
 // element should be automatically capitalized. This attribute is particularly
 // useful for input fields where the user is expected to enter text in a
@@ -158,8 +176,17 @@ func AutoCapitalize(value string) html.Node {
 	return html.NewAttribute("autocapitalize", value)
 }
 
+// AutoCapitalizeKeyword constructs the `autocapitalize` attribute from one of
+// the AutoCapitalizeValue constants. See AutoCapitalize.
+func AutoCapitalizeKeyword(value AutoCapitalizeValue) html.Node {
+	return html.NewAttribute("autocapitalize", string(value))
+}
+
 // AutoComplete constructs an html.Node for the `autocomplete` attribute.
 //
+// Use AutoCompleteKeywords to construct the attribute from the
+// AutoCompleteValue constants.
+//
 // The `autocomplete` attribute is used to specify whether or not an input
 // field should have autocomplete functionality enabled. Autocomplete
 // functionality provides suggestions or predictions as the user types, based
@@ -173,6 +200,12 @@ func AutoComplete(value string) html.Node {
 	return html.NewAttribute("autocomplete", value)
 }
 
+// AutoCompleteKeywords constructs the `autocomplete` attribute from
+// AutoCompleteValue constants, which are joined by spaces. See AutoComplete.
+func AutoCompleteKeywords(values ...AutoCompleteValue) html.Node {
+	return html.NewAttribute("autocomplete", join(values))
+}
+
 // Blocking constructs an html.Node for the `blocking` attribute.
 //
 // The `blocking` attribute is used to indicate whether a specified resource
@@ -254,6 +287,8 @@ func Color(value string) html.Node {
 
 // Cols constructs an html.Node for the `cols` attribute.
 //
+// Use ColsInt to construct the attribute from an int.
+//
 // `cols` is used to specify the number of columns in an HTML table. It
 // determines the layout and organization of data within the table, allowing
 // developers to define the width of each column. The value of the `cols`
@@ -278,8 +313,15 @@ func Cols(value string) html.Node {
 	return html.NewAttribute("cols", value)
 }
 
+// ColsInt constructs the `cols` attribute from an int.
+func ColsInt(value int) html.Node {
+	return attrvalue.Int("cols", value)
+}
+
 // ColSpan constructs an html.Node for the `colspan` attribute.
 //
+// Use ColSpanInt to construct the attribute from an int.
+//
 // `colspan` is used to specify the number of columns a cell should span in an
 // HTML table. It allows for the merging of multiple columns in a single cell,
 // creating a wider cell that spans across multiple columns. This attribute is
@@ -292,6 +334,11 @@ func ColSpan(value string) html.Node {
 	return html.NewAttribute("colspan", value)
 }
 
+// ColSpanInt constructs the `colspan` attribute from an int.
+func ColSpanInt(value int) html.Node {
+	return attrvalue.Int("colspan", value)
+}
+
 // Content constructs an html.Node for the `content` attribute.
 //
 // The `content` attribute is used to specify the content for a specific
@@ -309,6 +356,9 @@ func Content(value string) html.Node {
 
 // ContentEditable constructs an html.Node for the `contenteditable` attribute.
 //
+// Use ContentEditableKeyword to construct the attribute from the
+// ContentEditableValue constants.
+//
 // The `contenteditable` attribute is used to make an HTML element editable by
 // the user. When this attribute is set to "true", the element can be modified
 // directly on the webpage, allowing users to input text or make changes to the
@@ -323,6 +373,12 @@ func ContentEditable(value string) html.Node {
 	return html.NewAttribute("contenteditable", value)
 }
 
+// ContentEditableKeyword constructs the `contenteditable` attribute from one of
+// the ContentEditableValue constants. See ContentEditable.
+func ContentEditableKeyword(value ContentEditableValue) html.Node {
+	return html.NewAttribute("contenteditable", string(value))
+}
+
 // Coords constructs an html.Node for the `coords` attribute.
 //
 // The `coords` attribute is used to specify the coordinates of
@@ -347,6 +403,9 @@ func Coords(value string) html.Node {
 
 // CrossOrigin constructs an html.Node for the `crossorigin` attribute.
 //
+// Use CrossOriginKeyword to construct the attribute from the CrossOriginValue
+// constants.
+//
 // `crossorigin` is used to specify how the browser should handle cross-origin
 // resource requests when loading an external resource, such as a script or an
 // image. This attribute provides a way to control whether the browser should
@@ -364,25 +423,29 @@ func CrossOrigin(value string) html.Node {
 	return html.NewAttribute("crossorigin", value)
 }
 
+// CrossOriginKeyword constructs the `crossorigin` attribute from one of the
+// CrossOriginValue constants. See CrossOrigin.
+func CrossOriginKeyword(value CrossOriginValue) html.Node {
+	return html.NewAttribute("crossorigin", string(value))
+}
+
 // Data constructs an html.Node for the `data` attribute.
 //
-// `data` is a custom attribute that allows developers to store additional
-// information within an HTML element. It is used to attach data to specific
-// elements, providing a way to store metadata that is not visible or directly
-// relevant to the presentation or behavior of the element. The value of the
-// `data` attribute can be any valid string or JSON data, representing various
-// types of information such as configuration settings, dynamic data, or
-// customized data attributes.
+// The `data` attribute is the URL of the resource embedded by an <object>
+// element, like a PDF or an image. It is not a custom data attribute; use
+// DataAttr to construct `data-*` attributes.
 //
 // Example Usage:
-// <div data-id="1234" data-category="electronics">This div stores data about a product.</div>
-// <button data-action="submit-form" data-active="true">This button has data attributes to control its behavior.</button>
+// <object data="report.pdf" type="application/pdf" width="600" height="400"></object>
 func Data(value string) html.Node {
 	return html.NewAttribute("data", value)
 }
 
 // DateTime constructs an html.Node for the `datetime` attribute.
 //
+// Use DateTimeAt or DateTimeDuration to construct the attribute from a
+// time.Time or a time.Duration.
+//
 // The `datetime` attribute is used to specify a machine-readable date and time
 // value for an HTML element. It is primarily used for semantic markup and
 // improving accessibility. The value of the `datetime` attribute should follow
@@ -396,8 +459,24 @@ func DateTime(value string) html.Node {
 	return html.NewAttribute("datetime", value)
 }
 
+// DateTimeAt constructs the `datetime` attribute from a time.Time, formatted as
+// a global date and time, like 2006-01-02T15:04:05Z.
+func DateTimeAt(value time.Time) html.Node {
+	return globalDateTime("datetime", value)
+}
+
+// DateTimeDuration constructs the `datetime` attribute from a time.Duration,
+// formatted as a duration, like PT1H30M, which is only valid on <time>.
+// Negative durations are not valid and render as nothing.
+func DateTimeDuration(value time.Duration) html.Node {
+	return duration("datetime", value)
+}
+
 // Decoding constructs an html.Node for the `decoding` attribute.
 //
+// Use DecodingKeyword to construct the attribute from the DecodingValue
+// constants.
+//
 // The `decoding` attribute is used in HTML to specify how the browser should
 // decode and display media files. It allows developers to control how the video,
 // audio, or image content is processed and presented to the user. The value of
@@ -417,8 +496,16 @@ func Decoding(value string) html.Node {
 	return html.NewAttribute("decoding", value)
 }
 
+// DecodingKeyword constructs the `decoding` attribute from one of the
+// DecodingValue constants. See Decoding.
+func DecodingKeyword(value DecodingValue) html.Node {
+	return html.NewAttribute("decoding", string(value))
+}
+
 // Dir constructs an html.Node for the `dir` attribute.
 //
+// Use DirKeyword to construct the attribute from the DirValue constants.
+//
 // `dir` is used to specify the text directionality for the content within an
 // HTML element. It allows developers to control the ordering of text and the
 // orientation of characters within the element. The `dir` attribute can have two
@@ -432,6 +519,12 @@ func Dir(value string) html.Node {
 	return html.NewAttribute("dir", value)
 }
 
+// DirKeyword constructs the `dir` attribute from one of the DirValue constants.
+// See Dir.
+func DirKeyword(value DirValue) html.Node {
+	return html.NewAttribute("dir", string(value))
+}
+
 // DirName constructs an html.Node for the `dirname` attribute.
 //
 // `dirname` is used to specify the text directionality of the content within
@@ -450,6 +543,9 @@ func DirName(value string) html.Node {
 
 // Draggable constructs an html.Node for the `draggable` attribute.
 //
+// Use DraggableKeyword to construct the attribute from the DraggableValue
+// constants.
+//
 // The `draggable` attribute is used to indicate whether an element can be
 // dragged by the user. It can be applied to a wide range of HTML elements,
 // including images, text, and divs. When set to `true`, the element can be
@@ -464,8 +560,17 @@ func Draggable(value string) html.Node {
 	return html.NewAttribute("draggable", value)
 }
 
+// DraggableKeyword constructs the `draggable` attribute from one of the
+// DraggableValue constants. See Draggable.
+func DraggableKeyword(value DraggableValue) html.Node {
+	return html.NewAttribute("draggable", string(value))
+}
+
 // Enctype constructs an html.Node for the `enctype` attribute.
 //
+// Use EnctypeKeyword to construct the attribute from the EncTypeValue
+// constants.
+//
 // `enctype` is used to specify how form data should be encoded and sent to the
 // server when an HTML form is submitted. It is primarily used in the `<form>`
 // element to control how the data is formatted and transmitted. The `enctype`
@@ -481,8 +586,17 @@ func Enctype(value string) html.Node {
 	return html.NewAttribute("enctype", value)
 }
 
+// EnctypeKeyword constructs the `enctype` attribute from one of the
+// EncTypeValue constants. See Enctype.
+func EnctypeKeyword(value EncTypeValue) html.Node {
+	return html.NewAttribute("enctype", string(value))
+}
+
 // EnterKeyHint constructs an html.Node for the `enterkeyhint` attribute.
 //
+// Use EnterKeyHintKeyword to construct the attribute from the EnterKeyHintValue
+// constants.
+//
 // The `enterkeyhint` attribute is used to provide a hint to the browser about the
 // expected user action when the "Enter" key is pressed. It helps improve the
 // user experience by suggesting the appropriate action, such as submitting a
@@ -497,8 +611,17 @@ func EnterKeyHint(value string) html.Node {
 	return html.NewAttribute("enterkeyhint", value)
 }
 
+// EnterKeyHintKeyword constructs the `enterkeyhint` attribute from one of the
+// EnterKeyHintValue constants. See EnterKeyHint.
+func EnterKeyHintKeyword(value EnterKeyHintValue) html.Node {
+	return html.NewAttribute("enterkeyhint", string(value))
+}
+
 // FetchPriority constructs an html.Node for the `fetchpriority` attribute.
 //
+// Use FetchPriorityKeyword to construct the attribute from the
+// FetchPriorityValue constants.
+//
 // The `fetchpriority` attribute is used to indicate the priority of fetching a
 // resource in an HTML document. This attribute is typically used in the
 // `<link>` or `<img>` tags to specify the importance of retrieving the
@@ -514,6 +637,12 @@ func FetchPriority(value string) html.Node {
 	return html.NewAttribute("fetchpriority", value)
 }
 
+// FetchPriorityKeyword constructs the `fetchpriority` attribute from one of the
+// FetchPriorityValue constants. See FetchPriority.
+func FetchPriorityKeyword(value FetchPriorityValue) html.Node {
+	return html.NewAttribute("fetchpriority", string(value))
+}
+
 // For constructs an html.Node for the `for` attribute.
 //
 // The `for` attribute is used to create a relationship between a label element
@@ -560,6 +689,9 @@ func FormAction(value string) html.Node {
 
 // FormEncType constructs an html.Node for the `formenctype` attribute.
 //
+// Use FormEncTypeKeyword to construct the attribute from the EncTypeValue
+// constants.
+//
 // `formenctype` is used to specify the encoding type to be used when submitting
 // data from an HTML form to the server. It allows developers to indicate whether
 // the data should be encoded as `application/x-www-form-urlencoded` (the default)
@@ -578,8 +710,17 @@ func FormEncType(value string) html.Node {
 	return html.NewAttribute("formenctype", value)
 }
 
+// FormEncTypeKeyword constructs the `formenctype` attribute from one of the
+// EncTypeValue constants. See FormEncType.
+func FormEncTypeKeyword(value EncTypeValue) html.Node {
+	return html.NewAttribute("formenctype", string(value))
+}
+
 // FormMethod constructs an html.Node for the `formmethod` attribute.
 //
+// Use FormMethodKeyword to construct the attribute from the MethodValue
+// constants.
+//
 // `formmethod` is used to specify the HTTP method to be used when submitting
 // a form in an HTML document. This attribute is applied to the `button` or
 // `input` elements with a `type` of "submit" or "image". The `formmethod`
@@ -596,8 +737,17 @@ func FormMethod(value string) html.Node {
 	return html.NewAttribute("formmethod", value)
 }
 
+// FormMethodKeyword constructs the `formmethod` attribute from one of the
+// MethodValue constants. See FormMethod.
+func FormMethodKeyword(value MethodValue) html.Node {
+	return html.NewAttribute("formmethod", string(value))
+}
+
 // FormTarget constructs an html.Node for the `formtarget` attribute.
 //
+// Use FormTargetKeyword to construct the attribute from the TargetValue
+// constants.
+//
 // `formtarget` is used to specify where the form data should be submitted when
 // the user submits a form. It overrides the default behavior of submitting the
 // form to the same page. The value of the `formtarget` attribute can be a URL
@@ -617,6 +767,12 @@ func FormTarget(value string) html.Node {
 	return html.NewAttribute("formtarget", value)
 }
 
+// FormTargetKeyword constructs the `formtarget` attribute from one of the
+// TargetValue constants. See FormTarget.
+func FormTargetKeyword(value TargetValue) html.Node {
+	return html.NewAttribute("formtarget", string(value))
+}
+
 // Headers constructs an html.Node for the `headers` attribute.
 //
 // The `headers` attribute is used to establish a relationship between a data
@@ -642,6 +798,8 @@ func Headers(value string) html.Node {
 
 // Height constructs an html.Node for the `height` attribute.
 //
+// Use HeightInt to construct the attribute from an int.
+//
 // The `height` attribute is used to specify the height of an HTML element. It
 // determines the vertical size of the element and can be applied to various
 // types of elements such as images, tables, divs, and iframes. The value of
@@ -656,8 +814,15 @@ func Height(value string) html.Node {
 	return html.NewAttribute("height", value)
 }
 
+// HeightInt constructs the `height` attribute from an int.
+func HeightInt(value int) html.Node {
+	return attrvalue.Int("height", value)
+}
+
 // High constructs an html.Node for the `high` attribute.
 //
+// Use HighInt or HighFloat to construct the attribute from an int or a float64.
+//
 // The `high` attribute is used to specify a numerical value that represents
 // the importance or priority of an HTML element. It is primarily used in
 // ordered lists (ol) to indicate the level of importance for each list item.
@@ -675,6 +840,17 @@ func High(value string) html.Node {
 	return html.NewAttribute("high", value)
 }
 
+// HighInt constructs the `high` attribute from an int.
+func HighInt(value int) html.Node {
+	return attrvalue.Int("high", value)
+}
+
+// HighFloat constructs the `high` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func HighFloat(value float64) html.Node {
+	return attrvalue.Float("high", value)
+}
+
 // HRef constructs an html.Node for the `href` attribute.
 //
 // The `href` attribute is used to specify the URL of a linked resource in an
@@ -712,6 +888,9 @@ func HRefLang(value string) html.Node {
 
 // HttpEquiv constructs an html.Node for the `http-equiv` attribute.
 //
+// Use HttpEquivKeyword to construct the attribute from the HttpEquivValue
+// constants.
+//
 // `http-equiv` is used to provide an HTTP header for an HTML document.
 // It allows developers to specify information about the document's content type,
 // refresh rate, character encoding, and other important metadata that affects
@@ -728,6 +907,12 @@ func HttpEquiv(value string) html.Node {
 	return html.NewAttribute("http-equiv", value)
 }
 
+// HttpEquivKeyword constructs the `http-equiv` attribute from one of the
+// HttpEquivValue constants. See HttpEquiv.
+func HttpEquivKeyword(value HttpEquivValue) html.Node {
+	return html.NewAttribute("http-equiv", string(value))
+}
+
 // Id constructs an html.Node for the `id` attribute.
 //
 // The `id` attribute is used to uniquely identify an HTML element. It allows
@@ -776,6 +961,9 @@ func ImageSrcSet(value string) html.Node {
 
 // InputMode constructs an html.Node for the `inputmode` attribute.
 //
+// Use InputModeKeyword to construct the attribute from the InputModeValue
+// constants.
+//
 // `inputmode` is used to specify the expected input method for an HTML input
 // element. It helps optimize the user experience by suggesting the appropriate
 // on-screen keyboard layout or input method based on the expected input type.
@@ -792,6 +980,12 @@ func InputMode(value string) html.Node {
 	return html.NewAttribute("inputmode", value)
 }
 
+// InputModeKeyword constructs the `inputmode` attribute from one of the
+// InputModeValue constants. See InputMode.
+func InputModeKeyword(value InputModeValue) html.Node {
+	return html.NewAttribute("inputmode", string(value))
+}
+
 // Integrity constructs an html.Node for the `integrity` attribute.
 //
 // The `integrity` attribute is used to ensure the authenticity and integrity
@@ -895,6 +1089,8 @@ func ItemType(value string) html.Node {
 
 // Kind constructs an html.Node for the `kind` attribute.
 //
+// Use KindKeyword to construct the attribute from the KindValue constants.
+//
 // The `kind` attribute is used to specify the type or category of a media
 // resource in an HTML document. It is primarily used in the `<source>` element
 // within `<video>` or `<audio>` tags to provide alternative media sources. The
@@ -914,6 +1110,12 @@ func Kind(value string) html.Node {
 	return html.NewAttribute("kind", value)
 }
 
+// KindKeyword constructs the `kind` attribute from one of the KindValue
+// constants. See Kind.
+func KindKeyword(value KindValue) html.Node {
+	return html.NewAttribute("kind", string(value))
+}
+
 // Label constructs an html.Node for the `label` attribute.
 //
 // The `label` attribute is used to associate text with form elements in an HTML
@@ -969,6 +1171,9 @@ func List(value string) html.Node {
 
 // Loading constructs an html.Node for the `loading` attribute.
 //
+// Use LoadingKeyword to construct the attribute from the LoadingValue
+// constants.
+//
 // The `loading` attribute is used to control the loading behavior of external
 // resources, such as images or scripts, in an HTML document. It determines
 // when and how these resources are loaded, allowing developers to optimize
@@ -981,8 +1186,16 @@ func Loading(value string) html.Node {
 	return html.NewAttribute("loading", value)
 }
 
+// LoadingKeyword constructs the `loading` attribute from one of the
+// LoadingValue constants. See Loading.
+func LoadingKeyword(value LoadingValue) html.Node {
+	return html.NewAttribute("loading", string(value))
+}
+
 // Low constructs an html.Node for the `low` attribute.
 //
+// Use LowInt or LowFloat to construct the attribute from an int or a float64.
+//
 // The `low` attribute is used to indicate the lower bound value of a range in
 // an HTML input element. It is primarily used with the `input` element to
 // define the minimum value that can be selected or entered by the user. This
@@ -997,8 +1210,23 @@ func Low(value string) html.Node {
 	return html.NewAttribute("low", value)
 }
 
+// LowInt constructs the `low` attribute from an int.
+func LowInt(value int) html.Node {
+	return attrvalue.Int("low", value)
+}
+
+// LowFloat constructs the `low` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func LowFloat(value float64) html.Node {
+	return attrvalue.Float("low", value)
+}
+
 // Max constructs an html.Node for the `max` attribute.
 //
+// Use MaxInt or MaxFloat to construct the attribute from an int or a float64.
+// The limits of date and time inputs are constructed by the function for the
+// input's type, like MinDate or MaxWeek.
+//
 // The `max` attribute is used to set the maximum value that can be entered or
 // selected in an input field or element. It is commonly used with input types
 // such as "number" or "date" to define an upper limit for the value that can
@@ -1012,8 +1240,21 @@ func Max(value string) html.Node {
 	return html.NewAttribute("max", value)
 }
 
+// MaxInt constructs the `max` attribute from an int.
+func MaxInt(value int) html.Node {
+	return attrvalue.Int("max", value)
+}
+
+// MaxFloat constructs the `max` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func MaxFloat(value float64) html.Node {
+	return attrvalue.Float("max", value)
+}
+
 // MaxLength constructs an html.Node for the `maxlength` attribute.
 //
+// Use MaxLengthInt to construct the attribute from an int.
+//
 // `maxlength` is used to specify the maximum number of characters allowed in an
 // input field in an HTML form. It restricts the user from entering more characters
 // than the specified limit. The `maxlength` attribute is commonly used with text
@@ -1025,6 +1266,11 @@ func MaxLength(value string) html.Node {
 	return html.NewAttribute("maxlength", value)
 }
 
+// MaxLengthInt constructs the `maxlength` attribute from an int.
+func MaxLengthInt(value int) html.Node {
+	return attrvalue.Int("maxlength", value)
+}
+
 // Media constructs an html.Node for the `media` attribute.
 //
 // The `media` attribute is used to specify the media types for which a specific
@@ -1043,6 +1289,8 @@ func Media(value string) html.Node {
 
 // Method constructs an html.Node for the `method` attribute.
 //
+// Use MethodKeyword to construct the attribute from the MethodValue constants.
+//
 // The `method` attribute is used in a `<form>` element to specify the HTTP
 // request method to be used when submitting the form data to the server. It
 // determines how the data from the form will be transmitted. The `method`
@@ -1061,8 +1309,18 @@ func Method(value string) html.Node {
 	return html.NewAttribute("method", value)
 }
 
+// MethodKeyword constructs the `method` attribute from one of the MethodValue
+// constants. See Method.
+func MethodKeyword(value MethodValue) html.Node {
+	return html.NewAttribute("method", string(value))
+}
+
 // Min constructs an html.Node for the `min` attribute.
 //
+// Use MinInt or MinFloat to construct the attribute from an int or a float64.
+// The limits of date and time inputs are constructed by the function for the
+// input's type, like MinDate or MaxWeek.
+//
 // `min` is used to specify a minimum value for numerical input fields in an
 // HTML form. It restricts the range of acceptable input values to be greater
 // than or equal to the specified minimum value. The `min` attribute is
@@ -1082,8 +1340,21 @@ func Min(value string) html.Node {
 	return html.NewAttribute("min", value)
 }
 
+// MinInt constructs the `min` attribute from an int.
+func MinInt(value int) html.Node {
+	return attrvalue.Int("min", value)
+}
+
+// MinFloat constructs the `min` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func MinFloat(value float64) html.Node {
+	return attrvalue.Float("min", value)
+}
+
 // MinLength constructs an html.Node for the `minlength` attribute.
 //
+// Use MinLengthInt to construct the attribute from an int.
+//
 // `minlength` is used to specify the minimum number of characters or values
 // that should be entered or selected in an HTML input field or textarea. It is
 // primarily used to enforce data validation and ensure that a certain level of
@@ -1098,6 +1369,11 @@ func MinLength(value string) html.Node {
 	return html.NewAttribute("minlength", value)
 }
 
+// MinLengthInt constructs the `minlength` attribute from an int.
+func MinLengthInt(value int) html.Node {
+	return attrvalue.Int("minlength", value)
+}
+
 // Name constructs an html.Node for the `name` attribute.
 //
 // `name` is used to specify a name for an HTML element, typically used in form
@@ -1131,6 +1407,9 @@ func Nonce(value string) html.Node {
 
 // Optimum constructs an html.Node for the `optimum` attribute.
 //
+// Use OptimumInt or OptimumFloat to construct the attribute from an int or a
+// float64.
+//
 // The `optimum` attribute is used to specify the ideal or optimal value for a
 // progress element in an HTML document. It helps define the point at which the
 // task or process represented by the progress element is considered complete or
@@ -1144,6 +1423,17 @@ func Optimum(value string) html.Node {
 	return html.NewAttribute("optimum", value)
 }
 
+// OptimumInt constructs the `optimum` attribute from an int.
+func OptimumInt(value int) html.Node {
+	return attrvalue.Int("optimum", value)
+}
+
+// OptimumFloat constructs the `optimum` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func OptimumFloat(value float64) html.Node {
+	return attrvalue.Float("optimum", value)
+}
+
 // Pattern constructs an html.Node for the `pattern` attribute.
 //
 // The `pattern` attribute is used to specify a regular expression pattern that
@@ -1193,6 +1483,9 @@ func Placeholder(value string) html.Node {
 
 // PopOver constructs an html.Node for the `popover` attribute.
 //
+// Use PopOverKeyword to construct the attribute from the PopOverValue
+// constants.
+//
 // The `popover` attribute is used to create a pop-up dialog or tooltip that
 // displays additional information when a user interacts with an element. This
 // attribute is typically used in conjunction with JavaScript or CSS to define the
@@ -1206,6 +1499,12 @@ func PopOver(value string) html.Node {
 	return html.NewAttribute("popover", value)
 }
 
+// PopOverKeyword constructs the `popover` attribute from one of the
+// PopOverValue constants. See PopOver.
+func PopOverKeyword(value PopOverValue) html.Node {
+	return html.NewAttribute("popover", string(value))
+}
+
 // PopOverTarget constructs an html.Node for the `popovertarget` attribute.
 //
 // `popovertarget` is a custom attribute that can be added to HTML elements to
@@ -1231,6 +1530,9 @@ func PopOverTarget(value string) html.Node {
 
 // PopOverTargetAction constructs an html.Node for the `popovertargetaction` attribute.
 //
+// Use PopOverTargetActionKeyword to construct the attribute from the
+// PopOverTargetActionValue constants.
+//
 // The `popovertargetaction` attribute is used to specify the action that
 // should be performed when a target element is clicked or interacted with to
 // open a popover. It allows developers to define custom behavior for popovers,
@@ -1243,6 +1545,12 @@ func PopOverTargetAction(value string) html.Node {
 	return html.NewAttribute("popovertargetaction", value)
 }
 
+// PopOverTargetActionKeyword constructs the `popovertargetaction` attribute
+// from one of the PopOverTargetActionValue constants. See PopOverTargetAction.
+func PopOverTargetActionKeyword(value PopOverTargetActionValue) html.Node {
+	return html.NewAttribute("popovertargetaction", string(value))
+}
+
 // Poster constructs an html.Node for the `poster` attribute.
 //
 // The `poster` attribute is used to specify an image that should be displayed
@@ -1261,6 +1569,9 @@ func Poster(value string) html.Node {
 
 // PreLoad constructs an html.Node for the `preload` attribute.
 //
+// Use PreLoadKeyword to construct the attribute from the PreloadValue
+// constants.
+//
 // The `preload` attribute is used to provide a hint to the browser to load a
 // specific resource, such as an audio or video file, before it is actually
 // needed. This helps improve performance by reducing the delay in rendering
@@ -1280,8 +1591,17 @@ func PreLoad(value string) html.Node {
 	return html.NewAttribute("preload", value)
 }
 
+// PreLoadKeyword constructs the `preload` attribute from one of the
+// PreloadValue constants. See PreLoad.
+func PreLoadKeyword(value PreloadValue) html.Node {
+	return html.NewAttribute("preload", string(value))
+}
+
 // ReferrerPolicy constructs an html.Node for the `referrerpolicy` attribute.
 //
+// Use ReferrerPolicyKeyword to construct the attribute from the
+// ReferrerPolicyValue constants.
+//
 // The `referrerpolicy` attribute is used to control the referring information
 // that is sent when a user navigates from one webpage to another. It specifies
 // the policy that the browser should use when sending the `Referer` header, which
@@ -1298,8 +1618,16 @@ func ReferrerPolicy(value string) html.Node {
 	return html.NewAttribute("referrerpolicy", value)
 }
 
+// ReferrerPolicyKeyword constructs the `referrerpolicy` attribute from one of
+// the ReferrerPolicyValue constants. See ReferrerPolicy.
+func ReferrerPolicyKeyword(value ReferrerPolicyValue) html.Node {
+	return html.NewAttribute("referrerpolicy", string(value))
+}
+
 // Rel constructs an html.Node for the `rel` attribute.
 //
+// Use RelKeywords to construct the attribute from the RelValue constants.
+//
 // `rel` is used to specify the relationship between the current document and
 // the linked document in an HTML document. It is primarily used in anchor (a) tags
 // to indicate the type of relationship the linked document has with the current
@@ -1317,8 +1645,16 @@ func Rel(value string) html.Node {
 	return html.NewAttribute("rel", value)
 }
 
+// RelKeywords constructs the `rel` attribute from RelValue constants, which are
+// joined by spaces. See Rel.
+func RelKeywords(values ...RelValue) html.Node {
+	return html.NewAttribute("rel", join(values))
+}
+
 // Rows constructs an html.Node for the `rows` attribute.
 //
+// Use RowsInt to construct the attribute from an int.
+//
 // The `rows` attribute is used to specify the number of visible rows in a text
 // area or a table in HTML. It determines the height of the element, allowing
 // users to input or display multiline text or tabular data. The value of the
@@ -1344,8 +1680,15 @@ func Rows(value string) html.Node {
 	return html.NewAttribute("rows", value)
 }
 
+// RowsInt constructs the `rows` attribute from an int.
+func RowsInt(value int) html.Node {
+	return attrvalue.Int("rows", value)
+}
+
 // RowSpan constructs an html.Node for the `rowspan` attribute.
 //
+// Use RowSpanInt to construct the attribute from an int.
+//
 // The `rowspan` attribute is used to specify the number of rows that a table
 // cell should span vertically. It allows the content of a single cell to
 // occupy multiple rows in a table, merging the cells below it. This attribute
@@ -1358,8 +1701,16 @@ func RowSpan(value string) html.Node {
 	return html.NewAttribute("rowspan", value)
 }
 
+// RowSpanInt constructs the `rowspan` attribute from an int.
+func RowSpanInt(value int) html.Node {
+	return attrvalue.Int("rowspan", value)
+}
+
 // Sandbox constructs an html.Node for the `sandbox` attribute.
 //
+// Use SandboxKeywords to construct the attribute from the SandboxValue
+// constants.
+//
 // The `sandbox` attribute is used to restrict the behavior of an iframe element
 // within an HTML document. It creates a secure environment for the embedded
 // content, preventing it from accessing or modifying the parent document or
@@ -1389,8 +1740,16 @@ func Sandbox(value string) html.Node {
 	return html.NewAttribute("sandbox", value)
 }
 
+// SandboxKeywords constructs the `sandbox` attribute from SandboxValue
+// constants, which are joined by spaces. See Sandbox.
+func SandboxKeywords(values ...SandboxValue) html.Node {
+	return html.NewAttribute("sandbox", join(values))
+}
+
 // Scope constructs an html.Node for the `scope` attribute.
 //
+// Use ScopeKeyword to construct the attribute from the ScopeValue constants.
+//
 // The `scope` attribute is used to specify the scope of data cells in an HTML
 // table. It determines whether a header cell applies to a single column, a
 // single row, or a group of columns or rows. By defining the scope, assistive
@@ -1420,8 +1779,16 @@ func Scope(value string) html.Node {
 	return html.NewAttribute("scope", value)
 }
 
+// ScopeKeyword constructs the `scope` attribute from one of the ScopeValue
+// constants. See Scope.
+func ScopeKeyword(value ScopeValue) html.Node {
+	return html.NewAttribute("scope", string(value))
+}
+
 // Shape constructs an html.Node for the `shape` attribute.
 //
+// Use ShapeKeyword to construct the attribute from the ShapeValue constants.
+//
 // The `shape` attribute is used to define the shape of an area in an image map
 // in an HTML document. It is primarily used in conjunction with the `coords`
 // attribute to create clickable areas within an image. The value of the
@@ -1439,8 +1806,16 @@ func Shape(value string) html.Node {
 	return html.NewAttribute("shape", value)
 }
 
+// ShapeKeyword constructs the `shape` attribute from one of the ShapeValue
+// constants. See Shape.
+func ShapeKeyword(value ShapeValue) html.Node {
+	return html.NewAttribute("shape", string(value))
+}
+
 // Size constructs an html.Node for the `size` attribute.
 //
+// Use SizeInt to construct the attribute from an int.
+//
 // The `size` attribute is used to specify the visible width, in characters,
 // of an input element like text fields. This attribute allows developers to
 // control the width of the input field, giving users a visual indication of the
@@ -1453,6 +1828,11 @@ func Size(value string) html.Node {
 	return html.NewAttribute("size", value)
 }
 
+// SizeInt constructs the `size` attribute from an int.
+func SizeInt(value int) html.Node {
+	return attrvalue.Int("size", value)
+}
+
 // Sizes constructs an html.Node for the `sizes` attribute.
 //
 // The `sizes` attribute is used to specify the sizes of images or icons in an
@@ -1496,6 +1876,8 @@ func Slot(value string) html.Node {
 
 // Span constructs an html.Node for the `span` attribute.
 //
+// Use SpanInt to construct the attribute from an int.
+//
 // The `span` attribute is used to group inline elements and apply styles or
 // functionalities to them as a unit. It does not create any visual or
 // structural impact on the HTML document. It is often used to target specific
@@ -1510,8 +1892,16 @@ func Span(value string) html.Node {
 	return html.NewAttribute("span", value)
 }
 
+// SpanInt constructs the `span` attribute from an int.
+func SpanInt(value int) html.Node {
+	return attrvalue.Int("span", value)
+}
+
 // SpellCheck constructs an html.Node for the `spellcheck` attribute.
 //
+// Use SpellCheckKeyword to construct the attribute from the SpellCheckValue
+// constants.
+//
 // `spellcheck` is an attribute used to control the automatic spell checking
 // behavior of an HTML element. When this attribute is present, it informs the
 // browser whether the element's text content should be checked for spelling
@@ -1525,6 +1915,12 @@ func SpellCheck(value string) html.Node {
 	return html.NewAttribute("spellcheck", value)
 }
 
+// SpellCheckKeyword constructs the `spellcheck` attribute from one of the
+// SpellCheckValue constants. See SpellCheck.
+func SpellCheckKeyword(value SpellCheckValue) html.Node {
+	return html.NewAttribute("spellcheck", string(value))
+}
+
 // Src constructs an html.Node for the `src` attribute.
 //
 // `src` is used to specify the source URL or file path of an external resource
@@ -1593,6 +1989,8 @@ func SrcSet(value string) html.Node {
 
 // Start constructs an html.Node for the `start` attribute.
 //
+// Use StartInt to construct the attribute from an int.
+//
 // The `start` attribute is used to specify the starting number of an ordered
 // list in HTML. By default, ordered lists start at the number 1, but the
 // `start` attribute allows developers to customize the starting number of the
@@ -1615,8 +2013,15 @@ func Start(value string) html.Node {
 	return html.NewAttribute("start", value)
 }
 
+// StartInt constructs the `start` attribute from an int.
+func StartInt(value int) html.Node {
+	return attrvalue.Int("start", value)
+}
+
 // Step constructs an html.Node for the `step` attribute.
 //
+// Use StepInt or StepFloat to construct the attribute from an int or a float64.
+//
 // The `step` attribute is used to specify the interval or step size for
 // numeric input fields in an HTML form. It defines the amount by which the
 // value should increase or decrease when using the arrow controls or keyboard
@@ -1636,6 +2041,17 @@ func Step(value string) html.Node {
 	return html.NewAttribute("step", value)
 }
 
+// StepInt constructs the `step` attribute from an int.
+func StepInt(value int) html.Node {
+	return attrvalue.Int("step", value)
+}
+
+// StepFloat constructs the `step` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func StepFloat(value float64) html.Node {
+	return attrvalue.Float("step", value)
+}
+
 // Style constructs an html.Node for the `style` attribute.
 //
 // The `style` attribute is used to add inline CSS styles to an HTML element. It allows developers to directly apply specific visual formatting, such as color, font size, or padding, to individual elements within the HTML document. The value of the `style` attribute consists of one or more CSS property-value pairs, separated by semicolons. Each property-value pair defines a specific style rule that will be applied to the element.
@@ -1649,6 +2065,8 @@ func Style(value string) html.Node {
 
 // TabIndex constructs an html.Node for the `tabindex` attribute.
 //
+// Use TabIndexInt to construct the attribute from an int.
+//
 // `tabindex` is used to specify the order in which elements should be
 // navigated when the user interacts with a web page using the keyboard. It
 // allows developers to define a custom tab sequence for elements, ensuring
@@ -1670,8 +2088,15 @@ func TabIndex(value string) html.Node {
 	return html.NewAttribute("tabindex", value)
 }
 
+// TabIndexInt constructs the `tabindex` attribute from an int.
+func TabIndexInt(value int) html.Node {
+	return attrvalue.Int("tabindex", value)
+}
+
 // Target constructs an html.Node for the `target` attribute.
 //
+// Use TargetKeyword to construct the attribute from the TargetValue constants.
+//
 // The `target` attribute is used to specify where a linked resource should be
 // opened when clicked. It determines the browsing context in which the linked
 // resource should be loaded, such as a new window, a new tab, or the same frame
@@ -1688,6 +2113,12 @@ func Target(value string) html.Node {
 	return html.NewAttribute("target", value)
 }
 
+// TargetKeyword constructs the `target` attribute from one of the TargetValue
+// constants. See Target.
+func TargetKeyword(value TargetValue) html.Node {
+	return html.NewAttribute("target", string(value))
+}
+
 // Title constructs an html.Node for the `title` attribute.
 //
 // The `title` attribute is used to provide a text description or tooltip for
@@ -1706,6 +2137,9 @@ func Title(value string) html.Node {
 
 // Translate constructs an html.Node for the `translate` attribute.
 //
+// Use TranslateKeyword to construct the attribute from the TranslateValue
+// constants.
+//
 // The `translate` attribute is used to specify whether the content of an HTML
 // element should be translated or not. It is primarily used for localization
 // purposes, allowing developers to indicate if the text within an element
@@ -1721,8 +2155,17 @@ func Translate(value string) html.Node {
 	return html.NewAttribute("translate", value)
 }
 
+// TranslateKeyword constructs the `translate` attribute from one of the
+// TranslateValue constants. See Translate.
+func TranslateKeyword(value TranslateValue) html.Node {
+	return html.NewAttribute("translate", string(value))
+}
+
 // Type constructs an html.Node for the `type` attribute.
 //
+// Use TypeKeyword to construct the attribute from the InputTypeValue,
+// ButtonTypeValue, ScriptTypeValue or ListTypeValue constants.
+//
 // `type` is used to specify the type or format of data entered or displayed in
 // an HTML input element. It determines how the browser interprets and handles
 // the input, allowing for validation and control over user input. The value of
@@ -1736,6 +2179,12 @@ func Type(value string) html.Node {
 	return html.NewAttribute("type", value)
 }
 
+// TypeKeyword constructs the `type` attribute from one of the InputTypeValue,
+// ButtonTypeValue, ScriptTypeValue or ListTypeValue constants. See Type.
+func TypeKeyword[T InputTypeValue | ButtonTypeValue | ScriptTypeValue | ListTypeValue](value T) html.Node {
+	return html.NewAttribute("type", string(value))
+}
+
 // UseMap constructs an html.Node for the `usemap` attribute.
 //
 // `usemap` is used to associate an image with a client-side image map in an
@@ -1782,6 +2231,8 @@ func Value(value string) html.Node {
 
 // Width constructs an html.Node for the `width` attribute.
 //
+// Use WidthInt to construct the attribute from an int.
+//
 // The `width` attribute is used to specify the width of an HTML element. It allows
 // developers to control the size of elements, such as images, tables, or
 // containers, on a web page. The value of the `width` attribute can be specified
@@ -1802,8 +2253,15 @@ func Width(value string) html.Node {
 	return html.NewAttribute("width", value)
 }
 
+// WidthInt constructs the `width` attribute from an int.
+func WidthInt(value int) html.Node {
+	return attrvalue.Int("width", value)
+}
+
 // Wrap constructs an html.Node for the `wrap` attribute.
 //
+// Use WrapKeyword to construct the attribute from the WrapValue constants.
+//
 // The `wrap` attribute is used to specify how the text within a text area
 // should be wrapped when it exceeds the width of the text area. It determines
 // whether the text should wrap automatically or if horizontal scrolling should
@@ -1815,3 +2273,9 @@ func Width(value string) html.Node {
 func Wrap(value string) html.Node {
 	return html.NewAttribute("wrap", value)
 }
+
+// WrapKeyword constructs the `wrap` attribute from one of the WrapValue
+// constants. See Wrap.
+func WrapKeyword(value WrapValue) html.Node {
+	return html.NewAttribute("wrap", string(value))
+}
@@ -1,3 +1,5 @@
+// Code generated by internal/specgen from pkg/spec/attributes.json. DO NOT EDIT.
+
 package attr
 
 import "github.com/jeffswenson/sanity/pkg/html"
@@ -17,6 +19,15 @@ func AllowFullScreen() html.Node {
 	return html.NewBoolAttribute("allowfullscreen")
 }
 
+// AllowFullScreenIf constructs the `allowfullscreen` attribute if condition is
+// true. Otherwise it renders nothing.
+func AllowFullScreenIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("allowfullscreen")
+}
+
 // Async constructs an html.Node for the `async` attribute.
 //
 // The `async` attribute is used to specify that an external script should be
@@ -34,6 +45,15 @@ func Async() html.Node {
 	return html.NewBoolAttribute("async")
 }
 
+// AsyncIf constructs the `async` attribute if condition is true. Otherwise it
+// renders nothing.
+func AsyncIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("async")
+}
+
 // AutoFocus constructs an html.Node for the `autofocus` attribute.
 //
 // `autofocus` is an HTML attribute used to automatically focus on a specific
@@ -51,6 +71,15 @@ func AutoFocus() html.Node {
 	return html.NewBoolAttribute("autofocus")
 }
 
+// AutoFocusIf constructs the `autofocus` attribute if condition is true.
+// Otherwise it renders nothing.
+func AutoFocusIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("autofocus")
+}
+
 // AutoPlay constructs an html.Node for the `autoplay` attribute.
 //
 // The `autoplay` attribute is used to specify that a media element (such as an
@@ -66,6 +95,15 @@ func AutoPlay() html.Node {
 	return html.NewBoolAttribute("autoplay")
 }
 
+// AutoPlayIf constructs the `autoplay` attribute if condition is true.
+// Otherwise it renders nothing.
+func AutoPlayIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("autoplay")
+}
+
 // Checked constructs an html.Node for the `checked` attribute.
 //
 // `checked` is used to specify that an input element should be pre-selected or
@@ -85,6 +123,15 @@ func Checked() html.Node {
 	return html.NewBoolAttribute("checked")
 }
 
+// CheckedIf constructs the `checked` attribute if condition is true. Otherwise
+// it renders nothing.
+func CheckedIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("checked")
+}
+
 // Controls constructs an html.Node for the `controls` attribute.
 //
 // The `controls` attribute is used to add audio or video controls to an HTML
@@ -100,23 +147,40 @@ func Controls() html.Node {
 	return html.NewBoolAttribute("controls")
 }
 
-// TODO this doc comment looks wrong
+// ControlsIf constructs the `controls` attribute if condition is true.
+// Otherwise it renders nothing.
+func ControlsIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("controls")
+}
+
 // Default constructs an html.Node for the `default` attribute.
 //
-// The `default` attribute is used to specify a predefined value or state for
-// an input element in an HTML form. It allows developers to set a default
-// value that will be displayed when the form is initially loaded or reset.
-// This can be useful for providing suggested input or pre-filling form fields
-// with commonly used values.
+// The `default` attribute is used on a <track> element to mark it as the
+// track to enable when the user has not chosen a text track. At most one
+// <track> element of each kind in a <video> or <audio> element should have the
+// `default` attribute.
 //
 // Example Usage:
-// <input type="text" name="username" value="JohnDoe" default="JohnDoe">
-// <input type="checkbox" name="rememberMe" default="checked">
-// <textarea name="message" default="Please enter your message here."></textarea>
+// <video src="movie.mp4">
+// <track kind="subtitles" src="en.vtt" srclang="en" label="English" default>
+// <track kind="subtitles" src="fr.vtt" srclang="fr" label="Français">
+// </video>
 func Default() html.Node {
 	return html.NewBoolAttribute("default")
 }
 
+// DefaultIf constructs the `default` attribute if condition is true. Otherwise
+// it renders nothing.
+func DefaultIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("default")
+}
+
 // Defer constructs an html.Node for the `defer` attribute.
 //
 // The `defer` attribute is used to indicate that a script should be executed
@@ -133,6 +197,15 @@ func Defer() html.Node {
 	return html.NewBoolAttribute("defer")
 }
 
+// DeferIf constructs the `defer` attribute if condition is true. Otherwise it
+// renders nothing.
+func DeferIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("defer")
+}
+
 // Disabled constructs an html.Node for the `disabled` attribute.
 //
 // The `disabled` attribute is used to disable an HTML element, preventing user
@@ -150,6 +223,15 @@ func Disabled() html.Node {
 	return html.NewBoolAttribute("disabled")
 }
 
+// DisabledIf constructs the `disabled` attribute if condition is true.
+// Otherwise it renders nothing.
+func DisabledIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("disabled")
+}
+
 // FormNoValidate constructs an html.Node for the `formnovalidate` attribute.
 //
 // The `formnovalidate` attribute is used to override the default form
@@ -164,6 +246,15 @@ func FormNoValidate() html.Node {
 	return html.NewBoolAttribute("formnovalidate")
 }
 
+// FormNoValidateIf constructs the `formnovalidate` attribute if condition is
+// true. Otherwise it renders nothing.
+func FormNoValidateIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("formnovalidate")
+}
+
 // Hidden constructs an html.Node for the `hidden` attribute.
 //
 // The `hidden` attribute is used to hide an HTML element from display on a
@@ -180,6 +271,15 @@ func Hidden() html.Node {
 	return html.NewBoolAttribute("hidden")
 }
 
+// HiddenIf constructs the `hidden` attribute if condition is true. Otherwise it
+// renders nothing.
+func HiddenIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("hidden")
+}
+
 // Inert constructs an html.Node for the `inert` attribute.
 //
 // `inert` is used to indicate that an HTML element and its descendants should
@@ -195,6 +295,15 @@ func Inert() html.Node {
 	return html.NewBoolAttribute("inert")
 }
 
+// InertIf constructs the `inert` attribute if condition is true. Otherwise it
+// renders nothing.
+func InertIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("inert")
+}
+
 // IsMap constructs an html.Node for the `ismap` attribute.
 //
 // The `ismap` attribute is used to specify that an image in an HTML document
@@ -211,6 +320,15 @@ func IsMap() html.Node {
 	return html.NewBoolAttribute("ismap")
 }
 
+// IsMapIf constructs the `ismap` attribute if condition is true. Otherwise it
+// renders nothing.
+func IsMapIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("ismap")
+}
+
 // ItemScope constructs an html.Node for the `itemscope` attribute.
 //
 // `itemscope` is used to define the scope of an item in the HTML document. It
@@ -230,6 +348,15 @@ func ItemScope() html.Node {
 	return html.NewBoolAttribute("itemscope")
 }
 
+// ItemScopeIf constructs the `itemscope` attribute if condition is true.
+// Otherwise it renders nothing.
+func ItemScopeIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("itemscope")
+}
+
 // Loop constructs an html.Node for the `loop` attribute.
 //
 // The `loop` attribute is used to specify whether an audio or video element
@@ -247,6 +374,15 @@ func Loop() html.Node {
 	return html.NewBoolAttribute("loop")
 }
 
+// LoopIf constructs the `loop` attribute if condition is true. Otherwise it
+// renders nothing.
+func LoopIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("loop")
+}
+
 // Multiple constructs an html.Node for the `multiple` attribute.
 //
 // The `multiple` attribute is used to indicate that a user can select multiple
@@ -266,6 +402,15 @@ func Multiple() html.Node {
 	return html.NewBoolAttribute("multiple")
 }
 
+// MultipleIf constructs the `multiple` attribute if condition is true.
+// Otherwise it renders nothing.
+func MultipleIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("multiple")
+}
+
 // Muted constructs an html.Node for the `muted` attribute.
 //
 // The `muted` attribute is used to specify that the audio or video element
@@ -280,6 +425,15 @@ func Muted() html.Node {
 	return html.NewBoolAttribute("muted")
 }
 
+// MutedIf constructs the `muted` attribute if condition is true. Otherwise it
+// renders nothing.
+func MutedIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("muted")
+}
+
 // NoModule constructs an html.Node for the `nomodule` attribute.
 //
 // `nomodule` is used to specify that a JavaScript module should not be
@@ -297,6 +451,15 @@ func NoModule() html.Node {
 	return html.NewBoolAttribute("nomodule")
 }
 
+// NoModuleIf constructs the `nomodule` attribute if condition is true.
+// Otherwise it renders nothing.
+func NoModuleIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("nomodule")
+}
+
 // NoValidate constructs an html.Node for the `novalidate` attribute.
 //
 // `novalidate` is used to disable the default HTML5 form validation in an HTML
@@ -314,6 +477,15 @@ func NoValidate() html.Node {
 	return html.NewBoolAttribute("novalidate")
 }
 
+// NoValidateIf constructs the `novalidate` attribute if condition is true.
+// Otherwise it renders nothing.
+func NoValidateIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("novalidate")
+}
+
 // Open constructs an html.Node for the `open` attribute.
 //
 // The `open` attribute is used to specify whether a details element should be
@@ -337,6 +509,15 @@ func Open() html.Node {
 	return html.NewBoolAttribute("open")
 }
 
+// OpenIf constructs the `open` attribute if condition is true. Otherwise it
+// renders nothing.
+func OpenIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("open")
+}
+
 // PlaysInline constructs an html.Node for the `playsinline` attribute.
 //
 // The `playsinline` attribute is used to specify whether a video element
@@ -354,6 +535,15 @@ func PlaysInline() html.Node {
 	return html.NewBoolAttribute("playsinline")
 }
 
+// PlaysInlineIf constructs the `playsinline` attribute if condition is true.
+// Otherwise it renders nothing.
+func PlaysInlineIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("playsinline")
+}
+
 // ReadOnly constructs an html.Node for the `readonly` attribute.
 //
 // `readonly` is used to specify that an input element is read-only, meaning
@@ -370,6 +560,15 @@ func ReadOnly() html.Node {
 	return html.NewBoolAttribute("readonly")
 }
 
+// ReadOnlyIf constructs the `readonly` attribute if condition is true.
+// Otherwise it renders nothing.
+func ReadOnlyIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("readonly")
+}
+
 // Required constructs an html.Node for the `required` attribute.
 //
 // `required` is used to specify that an input field must be filled out before
@@ -387,6 +586,15 @@ func Required() html.Node {
 	return html.NewBoolAttribute("required")
 }
 
+// RequiredIf constructs the `required` attribute if condition is true.
+// Otherwise it renders nothing.
+func RequiredIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("required")
+}
+
 // Reversed constructs an html.Node for the `reversed` attribute.
 //
 // The `reversed` attribute is used in an ordered list (`<ol>`) element to
@@ -405,6 +613,15 @@ func Reversed() html.Node {
 	return html.NewBoolAttribute("reversed")
 }
 
+// ReversedIf constructs the `reversed` attribute if condition is true.
+// Otherwise it renders nothing.
+func ReversedIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("reversed")
+}
+
 // Selected constructs an html.Node for the `selected` attribute.
 //
 // The `selected` attribute is used to pre-select an option in a dropdown list or
@@ -427,3 +644,12 @@ func Reversed() html.Node {
 func Selected() html.Node {
 	return html.NewBoolAttribute("selected")
 }
+
+// SelectedIf constructs the `selected` attribute if condition is true.
+// Otherwise it renders nothing.
+func SelectedIf(condition bool) html.Node {
+	if !condition {
+		return html.Node{}
+	}
+	return html.NewBoolAttribute("selected")
+}
@@ -0,0 +1,84 @@
+package attr
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCatalogMatchesSpec reads the package's source to find the attribute
+// constructors and checks them against pkg/spec.
+func TestCatalogMatchesSpec(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	constructors := map[string]bool{}
+	for _, file := range packages["attr"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			var function string
+			switch fun := call.Fun.(type) {
+			case *ast.SelectorExpr:
+				function = fun.Sel.Name
+			case *ast.Ident:
+				function = fun.Name
+			}
+			switch function {
+			case "NewAttribute", "NewBoolAttribute":
+			default:
+				return true
+			}
+			literal, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+			name, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			boolean := function == "NewBoolAttribute"
+			require.Equal(t, spec.IsBooleanAttribute(name), boolean, "boolean-ness of %q", name)
+			constructors[name] = true
+			return true
+		})
+	}
+
+	for _, attribute := range spec.Attributes() {
+		if attribute.Event {
+			// Event handlers are in pkg/event.
+			continue
+		}
+		require.True(t, constructors[attribute.Name], "missing constructor for %q", attribute.Name)
+	}
+}
+
+// TestBoolAttributesHaveIf checks that every boolean attribute constructor
+// has a conditional variant.
+func TestBoolAttributesHaveIf(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "bool_attributes.go", nil, 0)
+	require.NoError(t, err)
+
+	functions := map[string]bool{}
+	for _, decl := range file.Decls {
+		if function, ok := decl.(*ast.FuncDecl); ok {
+			functions[function.Name.Name] = true
+		}
+	}
+	require.NotEmpty(t, functions)
+	for name := range functions {
+		if !strings.HasSuffix(name, "If") {
+			require.True(t, functions[name+"If"], "missing %sIf", name)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package attr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// DataAttr constructs an html.Node for a custom `data-*` attribute. The name
+// is given without the `data-` prefix. Custom data attributes store private
+// data for the page's scripts and style sheets, which can read them with
+// `element.dataset` and attribute selectors.
+//
+// The name must be a valid attribute name and must not contain upper case
+// ASCII letters or colons. If the name is invalid, DataAttr returns a node
+// that renders as nothing. See html.Invalid.
+//
+// Example Usage:
+// tag.Div(attr.DataAttr("user-id", "1234")) renders as
+// <div data-user-id="1234"></div>
+func DataAttr(name string, value string) html.Node {
+	if err := validateDataName(name); err != nil {
+		return html.Invalid(err)
+	}
+	return html.NewAttribute("data-"+name, value)
+}
+
+// DataJSON constructs an html.Node for a custom `data-*` attribute whose
+// value is v encoded as JSON. The value is escaped like any other attribute
+// value, so scripts can read it with JSON.parse(element.dataset.name). If v
+// can't be encoded or the name is invalid, DataJSON returns a node that
+// renders as nothing.
+//
+// Example Usage:
+// tag.Div(attr.DataJSON("config", map[string]int{"page": 2})) renders as
+// <div data-config="{&#34;page&#34;:2}"></div>
+func DataJSON(name string, v any) html.Node {
+	if err := validateDataName(name); err != nil {
+		return html.Invalid(err)
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return html.Invalid(fmt.Errorf("attr: encoding data-%s: %w", name, err))
+	}
+	return html.NewAttribute("data-"+name, string(value))
+}
+
+// validateDataName checks the rules the HTML standard adds for the part of a
+// custom data attribute's name that follows `data-`.
+func validateDataName(name string) error {
+	if name == "" {
+		return fmt.Errorf("attr: data attribute name is empty")
+	}
+	if i := strings.IndexAny(name, "ABCDEFGHIJKLMNOPQRSTUVWXYZ:"); i != -1 {
+		return fmt.Errorf("attr: invalid data attribute name %q: %q is not allowed in data attribute names", name, name[i])
+	}
+	if err := html.ValidateAttributeName("data-" + name); err != nil {
+		return fmt.Errorf("attr: invalid data attribute name %q: %w", name, err)
+	}
+	return nil
+}
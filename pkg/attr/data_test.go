@@ -0,0 +1,40 @@
+package attr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataAttr(t *testing.T) {
+	require.Equal(t,
+		`<div data-user-id="1234" data-note="&lt;b&gt;"></div>`,
+		tag.Div(DataAttr("user-id", "1234"), DataAttr("note", "<b>")).String())
+
+	for _, name := range []string{"", "userId", "x:y", "a b", "a>b"} {
+		require.Equal(t, "<div></div>", tag.Div(DataAttr(name, "v")).String(), name)
+	}
+}
+
+func TestDataJSON(t *testing.T) {
+	type config struct {
+		Page  int      `json:"page"`
+		Sort  string   `json:"sort"`
+		Flags []string `json:"flags"`
+	}
+	require.Equal(t,
+		`<div data-config="{&#34;page&#34;:2,&#34;sort&#34;:&#34;\u003c\u0026\u003e&#34;,&#34;flags&#34;:[&#34;a&#39;b&#34;]}"></div>`,
+		tag.Div(DataJSON("config", config{Page: 2, Sort: "<&>", Flags: []string{"a'b"}})).String())
+
+	require.Equal(t, "<div></div>", tag.Div(DataJSON("config", make(chan int))).String())
+	require.Equal(t, "<div></div>", tag.Div(DataJSON("Config", 1)).String())
+}
+
+func TestDataAttrStrict(t *testing.T) {
+	var buffer strings.Builder
+	err := tag.Div(DataAttr("userId", "1")).RenderTo(&buffer, html.Strict())
+	require.EqualError(t, err, `html: strict: attr: invalid data attribute name "userId": 'I' is not allowed in data attribute names`)
+}
@@ -0,0 +1,79 @@
+package attr
+
+import (
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywords(t *testing.T) {
+	tests := []struct {
+		node   html.Node
+		result string
+	}{
+		{TypeKeyword(InputTypeEmail), `type="email"`},
+		{TypeKeyword(ButtonTypeSubmit), `type="submit"`},
+		{TypeKeyword(ListTypeUpperRoman), `type="I"`},
+		{Type("text/css"), `type="text/css"`},
+		{TargetKeyword(TargetBlank), `target="_blank"`},
+		{FormTargetKeyword(TargetTop), `formtarget="_top"`},
+		{Target("preview"), `target="preview"`},
+		{LoadingKeyword(LoadingLazy), `loading="lazy"`},
+		{DecodingKeyword(DecodingAsync), `decoding="async"`},
+		{CrossOriginKeyword(CrossOriginUseCredentials), `crossorigin="use-credentials"`},
+		{ReferrerPolicyKeyword(ReferrerPolicyStrictOriginWhenCrossOrigin), `referrerpolicy="strict-origin-when-cross-origin"`},
+		{InputModeKeyword(InputModeNumeric), `inputmode="numeric"`},
+		{EnterKeyHintKeyword(EnterKeyHintSend), `enterkeyhint="send"`},
+		{MethodKeyword(MethodPost), `method="post"`},
+		{FormEncTypeKeyword(EncTypeMultipart), `formenctype="multipart/form-data"`},
+		{HttpEquivKeyword(HttpEquivContentSecurityPolicy), `http-equiv="content-security-policy"`},
+	}
+	for _, tc := range tests {
+		require.Equal(t, "<div "+tc.result+"></div>", tag.Div(tc.node).String())
+	}
+}
+
+func TestTokenLists(t *testing.T) {
+	tests := []struct {
+		node   html.Node
+		result string
+	}{
+		{RelKeywords(RelStylesheet), `rel="stylesheet"`},
+		{RelKeywords(RelNoOpener, RelNoReferrer), `rel="noopener noreferrer"`},
+		{RelKeywords(RelPreload, "x-custom"), `rel="preload x-custom"`},
+		{Rel("stylesheet"), `rel="stylesheet"`},
+		{Rel("preload x-custom"), `rel="preload x-custom"`},
+		{AutoCompleteKeywords(AutoCompleteShipping, AutoCompleteStreetAddress), `autocomplete="shipping street-address"`},
+		{AutoComplete("section-blue email"), `autocomplete="section-blue email"`},
+		{SandboxKeywords(SandboxAllowScripts, SandboxAllowForms), `sandbox="allow-scripts allow-forms"`},
+		{SandboxKeywords(), `sandbox=""`},
+	}
+	for _, tc := range tests {
+		require.Equal(t, "<div "+tc.result+"></div>", tag.Div(tc.node).String())
+	}
+}
+
+func TestKeywordStringConstructors(t *testing.T) {
+	// The constructors of enumerated attributes accept plain strings, so
+	// they can be passed around as functions.
+	constructors := map[string]func(string) html.Node{"rel": Rel, "type": Type, "target": Target, "sandbox": Sandbox}
+	for name, constructor := range constructors {
+		require.Equal(t, `<div `+name+`="x"></div>`, tag.Div(constructor("x")).String())
+	}
+}
+
+func TestKeywordsMatchSpec(t *testing.T) {
+	keywords := func(name string) []string {
+		attribute, ok := spec.LookupAttribute(name)
+		require.True(t, ok, name)
+		return attribute.Keywords
+	}
+	require.Contains(t, keywords("type"), string(InputTypeDateTimeLocal))
+	require.Contains(t, keywords("type"), string(ScriptTypeImportMap))
+	require.Contains(t, keywords("formtarget"), string(TargetBlank))
+	require.Equal(t, []string{"lazy", "eager"}, keywords("loading"))
+	require.Empty(t, keywords("href"))
+}
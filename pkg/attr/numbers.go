@@ -0,0 +1,124 @@
+package attr
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// globalDateTime formats t as a valid global date and time string, like
+// "2006-01-02T15:04:05Z".
+func globalDateTime(name string, t time.Time) html.Node {
+	return html.NewAttribute(name, t.Format("2006-01-02T15:04:05.999Z07:00"))
+}
+
+// duration formats d as a valid duration string, like "PT1H30M" or
+// "PT0.25S". Durations are limited to milliseconds, so d is rounded.
+func duration(name string, d time.Duration) html.Node {
+	if d < 0 {
+		return html.Invalid(fmt.Errorf("attr: invalid duration %v for %q: durations may not be negative", d, name))
+	}
+	d = d.Round(time.Millisecond)
+	var buffer [32]byte
+	b := append(buffer[:0], "PT"...)
+	if hours := d / time.Hour; hours != 0 {
+		b = strconv.AppendInt(b, int64(hours), 10)
+		b = append(b, 'H')
+	}
+	if minutes := d % time.Hour / time.Minute; minutes != 0 {
+		b = strconv.AppendInt(b, int64(minutes), 10)
+		b = append(b, 'M')
+	}
+	seconds := d % time.Minute / time.Second
+	milliseconds := d % time.Second / time.Millisecond
+	if seconds != 0 || milliseconds != 0 || d == 0 {
+		b = strconv.AppendInt(b, int64(seconds), 10)
+		if milliseconds != 0 {
+			b = append(b, '.', byte('0'+milliseconds/100), byte('0'+milliseconds/10%10), byte('0'+milliseconds%10))
+			for b[len(b)-1] == '0' {
+				b = b[:len(b)-1]
+			}
+		}
+		b = append(b, 'S')
+	}
+	return html.NewAttribute(name, string(b))
+}
+
+// MinDate constructs the `min` attribute of a date input, like
+// min="2006-01-02".
+func MinDate(t time.Time) html.Node {
+	return html.NewAttribute("min", t.Format("2006-01-02"))
+}
+
+// MaxDate constructs the `max` attribute of a date input, like
+// max="2006-01-02".
+func MaxDate(t time.Time) html.Node {
+	return html.NewAttribute("max", t.Format("2006-01-02"))
+}
+
+// MinMonth constructs the `min` attribute of a month input, like
+// min="2006-01".
+func MinMonth(t time.Time) html.Node {
+	return html.NewAttribute("min", t.Format("2006-01"))
+}
+
+// MaxMonth constructs the `max` attribute of a month input, like
+// max="2006-01".
+func MaxMonth(t time.Time) html.Node {
+	return html.NewAttribute("max", t.Format("2006-01"))
+}
+
+// MinWeek constructs the `min` attribute of a week input from the ISO 8601
+// week containing t, like min="2006-W01".
+func MinWeek(t time.Time) html.Node {
+	return html.NewAttribute("min", week(t))
+}
+
+// MaxWeek constructs the `max` attribute of a week input from the ISO 8601
+// week containing t, like max="2006-W01".
+func MaxWeek(t time.Time) html.Node {
+	return html.NewAttribute("max", week(t))
+}
+
+// MinTime constructs the `min` attribute of a time input from the time of
+// day of t, like min="15:04" or min="15:04:05.5".
+func MinTime(t time.Time) html.Node {
+	return html.NewAttribute("min", timeOfDay(t))
+}
+
+// MaxTime constructs the `max` attribute of a time input from the time of
+// day of t, like max="15:04" or max="15:04:05.5".
+func MaxTime(t time.Time) html.Node {
+	return html.NewAttribute("max", timeOfDay(t))
+}
+
+// MinDateTimeLocal constructs the `min` attribute of a datetime-local input
+// from the date and time of day of t, like min="2006-01-02T15:04". The time
+// zone of t is not included.
+func MinDateTimeLocal(t time.Time) html.Node {
+	return html.NewAttribute("min", t.Format("2006-01-02T")+timeOfDay(t))
+}
+
+// MaxDateTimeLocal constructs the `max` attribute of a datetime-local input
+// from the date and time of day of t, like max="2006-01-02T15:04". The time
+// zone of t is not included.
+func MaxDateTimeLocal(t time.Time) html.Node {
+	return html.NewAttribute("max", t.Format("2006-01-02T")+timeOfDay(t))
+}
+
+// week formats the ISO 8601 week containing t as a valid week string.
+func week(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// timeOfDay formats t as a valid time string. Seconds are omitted when they
+// are zero, like the value browsers submit.
+func timeOfDay(t time.Time) string {
+	if t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format("15:04")
+	}
+	return t.Format("15:04:05.999")
+}
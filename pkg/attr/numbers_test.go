@@ -0,0 +1,93 @@
+package attr
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumbers(t *testing.T) {
+	date := time.Date(2024, time.March, 9, 14, 30, 5, 250_000_000, time.UTC)
+	tests := []struct {
+		node   html.Node
+		result string
+	}{
+		{WidthInt(640), `width="640"`},
+		{Height("480"), `height="480"`},
+		{ColSpanInt(2), `colspan="2"`},
+		{TabIndexInt(-1), `tabindex="-1"`},
+		{MaxLengthInt(280), `maxlength="280"`},
+		{StepFloat(0.01), `step="0.01"`},
+		{Step("any"), `step="any"`},
+		{StepFloat(1e21), `step="1000000000000000000000"`},
+		{OptimumFloat(-2.5), `optimum="-2.5"`},
+		{MinInt(0), `min="0"`},
+		{MaxFloat(99.5), `max="99.5"`},
+		{MinDate(date), `min="2024-03-09"`},
+		{MaxDate(date), `max="2024-03-09"`},
+		{MinMonth(date), `min="2024-03"`},
+		{MaxWeek(date), `max="2024-W10"`},
+		{MinWeek(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)), `min="2020-W53"`},
+		{MinTime(date), `min="14:30:05.25"`},
+		{MaxTime(date.Truncate(time.Minute)), `max="14:30"`},
+		{MinDateTimeLocal(date.Truncate(time.Minute)), `min="2024-03-09T14:30"`},
+		{MaxDateTimeLocal(date.In(time.FixedZone("", -5*60*60))), `max="2024-03-09T09:30:05.25"`},
+		{DateTimeAt(date), `datetime="2024-03-09T14:30:05.25Z"`},
+		{DateTimeAt(date.In(time.FixedZone("", -5*60*60))), `datetime="2024-03-09T09:30:05.25-05:00"`},
+		{DateTime("2024-03"), `datetime="2024-03"`},
+		{DateTimeDuration(90 * time.Minute), `datetime="PT1H30M"`},
+		{DateTimeDuration(0), `datetime="PT0S"`},
+		{DateTimeDuration(26*time.Hour + 250*time.Millisecond), `datetime="PT26H0.25S"`},
+		{DateTimeDuration(1500 * time.Microsecond), `datetime="PT0.002S"`},
+	}
+	for _, tc := range tests {
+		require.Equal(t, "<div "+tc.result+"></div>", tag.Div(tc.node).String())
+	}
+}
+
+func TestInvalidNumbers(t *testing.T) {
+	tests := []struct {
+		node html.Node
+		err  string
+	}{
+		{StepFloat(math.NaN()), `html: strict: invalid number NaN for attribute "step"`},
+		{MaxFloat(math.Inf(1)), `html: strict: invalid number +Inf for attribute "max"`},
+		{DateTimeDuration(-time.Second), `html: strict: attr: invalid duration -1s for "datetime": durations may not be negative`},
+	}
+	for _, tc := range tests {
+		require.Equal(t, "<div></div>", tag.Div(tc.node).String())
+
+		var buffer strings.Builder
+		err := tag.Div(tc.node).RenderTo(&buffer, html.Strict())
+		require.EqualError(t, err, tc.err)
+	}
+}
+
+func TestNumberAllocations(t *testing.T) {
+	// The formatted value is the only allocation.
+	require.Equal(t, 1.0, testing.AllocsPerRun(100, func() { WidthInt(1280) }))
+	require.Equal(t, 1.0, testing.AllocsPerRun(100, func() { StepFloat(0.25) }))
+	require.Equal(t, 1.0, testing.AllocsPerRun(100, func() { DateTimeDuration(90 * time.Minute) }))
+	require.Equal(t, 0.0, testing.AllocsPerRun(100, func() { Width("1280") }))
+}
+
+func TestStringConstructors(t *testing.T) {
+	// The constructors of numeric attributes keep their string signatures,
+	// so they can be passed around as functions.
+	constructors := map[string]func(string) html.Node{
+		"width":    Width,
+		"colspan":  ColSpan,
+		"tabindex": TabIndex,
+		"max":      Max,
+		"step":     Step,
+		"datetime": DateTime,
+	}
+	for name, constructor := range constructors {
+		require.Equal(t, `<div `+name+`="1"></div>`, tag.Div(constructor("1")).String())
+	}
+}
@@ -0,0 +1,16 @@
+package attr
+
+import "github.com/jeffswenson/sanity/pkg/html"
+
+// Optional constructs the attribute if value is not nil. Otherwise it renders
+// nothing. It is useful for optional fields of a model, which are often
+// pointers.
+//
+// Example Usage:
+// tag.Img(attr.Src(user.AvatarURL), attr.Optional("alt", user.AvatarAlt))
+func Optional(name string, value *string) html.Node {
+	if value == nil {
+		return html.Node{}
+	}
+	return html.NewAttribute(name, *value)
+}
@@ -0,0 +1,24 @@
+package attr
+
+import (
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptional(t *testing.T) {
+	title := `"quoted"`
+	empty := ""
+	require.Equal(t, `<div title="&#34;quoted&#34;"></div>`, tag.Div(Optional("title", &title)).String())
+	require.Equal(t, `<div title=""></div>`, tag.Div(Optional("title", &empty)).String())
+	require.Equal(t, `<div></div>`, tag.Div(Optional("title", nil)).String())
+	require.Equal(t, `<div></div>`, tag.Div(Optional("bad name", &title)).String())
+}
+
+func TestBoolIf(t *testing.T) {
+	require.Equal(t, `<input type="checkbox" checked>`, tag.Input(TypeKeyword(InputTypeCheckbox), CheckedIf(true)).String())
+	require.Equal(t, `<input type="checkbox">`, tag.Input(TypeKeyword(InputTypeCheckbox), CheckedIf(false)).String())
+	require.Equal(t, `<details open></details>`, tag.Details(OpenIf(true)).String())
+	require.Equal(t, `<button></button>`, tag.Button(DisabledIf(false)).String())
+}
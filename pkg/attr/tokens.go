@@ -0,0 +1,15 @@
+package attr
+
+import "strings"
+
+// join joins the tokens of a token list attribute, like `rel`, with spaces.
+func join[T ~string](tokens []T) string {
+	var b strings.Builder
+	for i, token := range tokens {
+		if i != 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(token))
+	}
+	return b.String()
+}
@@ -0,0 +1,29 @@
+// Package event contains a function for every event handler attribute in the
+// HTML standard, like `onclick` and `onsubmit`. Handlers take a js.Script, so
+// values passed to the handler are encoded as JavaScript instead of being
+// pasted into the code.
+//
+// The handlers for the events of the Window object, like OnPopState, only
+// apply to <body>.
+//
+// Example Usage:
+//
+//	tag.Button(
+//		event.OnClick(js.Call("removeItem", js.This, item.ID)),
+//		html.InnerText("Remove"),
+//	)
+package event
+
+import (
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/js"
+)
+
+// handler constructs the event handler attribute. Scripts that failed to
+// build render as nothing. See html.Invalid.
+func handler(name string, script js.Script) html.Node {
+	if err := script.Err(); err != nil {
+		return html.Invalid(err)
+	}
+	return html.NewAttribute(name, script.String())
+}
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/js"
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlers(t *testing.T) {
+	require.Equal(t,
+		`<button onclick="toggle(&#34;menu&#34;)">Menu</button>`,
+		tag.Button(OnClick(js.Call("toggle", "menu")), html.InnerText("Menu")).String())
+
+	require.Equal(t,
+		`<form onsubmit="return confirm(&#39;Delete?&#39;)"></form>`,
+		tag.Form(OnSubmit(js.Raw("return confirm('Delete?')"))).String())
+
+	// The value can't close the string or the attribute.
+	require.Equal(t,
+		`<input oninput="search(this,&#34;\&#34; onfocus=\&#34;alert(1)&#34;)">`,
+		tag.Input(OnInput(js.Call("search", js.This, `" onfocus="alert(1)`))).String())
+
+	require.Equal(t,
+		`<body onpopstate="render(event.state)"></body>`,
+		tag.Body(OnPopState(js.Raw("render(event.state)"))).String())
+}
+
+func TestHandlerError(t *testing.T) {
+	node := tag.Button(OnClick(js.Call("alert(1);toggle")))
+	require.Equal(t, "<button></button>", node.String())
+
+	var buffer strings.Builder
+	err := node.RenderTo(&buffer, html.Strict())
+	require.EqualError(t, err, `html: strict: js: invalid function name "alert(1);toggle"`)
+}
+
+// TestCatalogMatchesSpec reads the package's source to check that there is a
+// handler for every event handler attribute in pkg/spec.
+func TestCatalogMatchesSpec(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	handlers := map[string]bool{}
+	for _, file := range packages["event"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if ident, ok := call.Fun.(*ast.Ident); !ok || ident.Name != "handler" {
+				return true
+			}
+			literal, ok := call.Args[0].(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+			name, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			require.True(t, spec.IsEventHandler(name), name)
+			handlers[name] = true
+			return true
+		})
+	}
+
+	count := 0
+	for _, attribute := range spec.Attributes() {
+		if attribute.Event {
+			count++
+			require.True(t, handlers[attribute.Name], "missing handler for %q", attribute.Name)
+		}
+	}
+	require.Len(t, handlers, count)
+}
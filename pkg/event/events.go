@@ -0,0 +1,996 @@
+// Code generated by internal/specgen from pkg/spec/attributes.json. DO NOT EDIT.
+
+package event
+
+import (
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/js"
+)
+
+// OnAbort constructs an html.Node for the `onabort` event handler attribute.
+//
+// The `onabort` handler runs when the browser stops loading the element's
+// resource before it finished, for example because the user navigated away.
+//
+// Example Usage:
+// <video src="intro.mp4" onabort="reportAbort(this)"></video>
+func OnAbort(script js.Script) html.Node {
+	return handler("onabort", script)
+}
+
+// OnAfterPrint constructs an html.Node for the `onafterprint` event handler attribute.
+//
+// The `onafterprint` handler runs when the user has printed the document or
+// closed the print preview.
+//
+// Example Usage:
+// <body onafterprint="restoreLayout()">...</body>
+func OnAfterPrint(script js.Script) html.Node {
+	return handler("onafterprint", script)
+}
+
+// OnAuxClick constructs an html.Node for the `onauxclick` event handler attribute.
+//
+// The `onauxclick` handler runs when the user presses and releases a
+// non-primary button, like the middle mouse button, on the element.
+//
+// Example Usage:
+// <a href="/docs" onauxclick="trackOpenInTab(this)">Docs</a>
+func OnAuxClick(script js.Script) html.Node {
+	return handler("onauxclick", script)
+}
+
+// OnBeforeInput constructs an html.Node for the `onbeforeinput` event handler attribute.
+//
+// The `onbeforeinput` handler runs when the value of an input, textarea, or
+// contenteditable element is about to change. Calling event.preventDefault()
+// cancels the change.
+//
+// Example Usage:
+// <div contenteditable onbeforeinput="limitLength(event)"></div>
+func OnBeforeInput(script js.Script) html.Node {
+	return handler("onbeforeinput", script)
+}
+
+// OnBeforeMatch constructs an html.Node for the `onbeforematch` event handler attribute.
+//
+// The `onbeforematch` handler runs when an element with hidden="until-found"
+// is about to be revealed by find-in-page or by navigating to a fragment.
+//
+// Example Usage:
+// <div hidden="until-found" onbeforematch="expandSection(this)">...</div>
+func OnBeforeMatch(script js.Script) html.Node {
+	return handler("onbeforematch", script)
+}
+
+// OnBeforePrint constructs an html.Node for the `onbeforeprint` event handler attribute.
+//
+// The `onbeforeprint` handler runs when the user is about to print the
+// document or open the print preview.
+//
+// Example Usage:
+// <body onbeforeprint="expandAllSections()">...</body>
+func OnBeforePrint(script js.Script) html.Node {
+	return handler("onbeforeprint", script)
+}
+
+// OnBeforeToggle constructs an html.Node for the `onbeforetoggle` event handler attribute.
+//
+// The `onbeforetoggle` handler runs when a popover or dialog is about to be
+// shown or hidden. The event's newState property is "open" or "closed".
+//
+// Example Usage:
+// <div id="menu" popover onbeforetoggle="loadMenu(event)"></div>
+func OnBeforeToggle(script js.Script) html.Node {
+	return handler("onbeforetoggle", script)
+}
+
+// OnBeforeUnload constructs an html.Node for the `onbeforeunload` event handler attribute.
+//
+// The `onbeforeunload` handler runs when the user is about to leave the page.
+// Calling event.preventDefault() asks the user to confirm.
+//
+// Example Usage:
+// <body onbeforeunload="if (hasUnsavedChanges()) event.preventDefault()">...</body>
+func OnBeforeUnload(script js.Script) html.Node {
+	return handler("onbeforeunload", script)
+}
+
+// OnBlur constructs an html.Node for the `onblur` event handler attribute.
+//
+// The `onblur` handler runs when the element loses focus. Unlike focusout, the
+// event does not bubble.
+//
+// Example Usage:
+// <input name="email" onblur="validateEmail(this)">
+func OnBlur(script js.Script) html.Node {
+	return handler("onblur", script)
+}
+
+// OnCancel constructs an html.Node for the `oncancel` event handler attribute.
+//
+// The `oncancel` handler runs when the user dismisses a modal dialog with the
+// Escape key or closes a file picker without choosing a file.
+//
+// Example Usage:
+// <dialog oncancel="confirmDiscard(event)">...</dialog>
+func OnCancel(script js.Script) html.Node {
+	return handler("oncancel", script)
+}
+
+// OnCanPlay constructs an html.Node for the `oncanplay` event handler attribute.
+//
+// The `oncanplay` handler runs when a media element has loaded enough data to
+// start playing, but may need to stop to buffer later.
+//
+// Example Usage:
+// <video src="intro.mp4" oncanplay="hideSpinner()"></video>
+func OnCanPlay(script js.Script) html.Node {
+	return handler("oncanplay", script)
+}
+
+// OnCanPlayThrough constructs an html.Node for the `oncanplaythrough` event handler attribute.
+//
+// The `oncanplaythrough` handler runs when the browser estimates that a media
+// element can play to the end without stopping to buffer.
+//
+// Example Usage:
+// <audio src="song.mp3" oncanplaythrough="enablePlay()"></audio>
+func OnCanPlayThrough(script js.Script) html.Node {
+	return handler("oncanplaythrough", script)
+}
+
+// OnChange constructs an html.Node for the `onchange` event handler attribute.
+//
+// The `onchange` handler runs when the user commits a change to the value of a
+// form control, like choosing an option or leaving a text field after editing
+// it.
+//
+// Example Usage:
+// <select name="country" onchange="updateRegions(this.value)">...</select>
+func OnChange(script js.Script) html.Node {
+	return handler("onchange", script)
+}
+
+// OnClick constructs an html.Node for the `onclick` event handler attribute.
+//
+// The `onclick` handler runs when the user clicks the element with the primary
+// pointer button or activates it with the keyboard.
+//
+// Example Usage:
+// <button onclick="toggleMenu()">Menu</button>
+func OnClick(script js.Script) html.Node {
+	return handler("onclick", script)
+}
+
+// OnClose constructs an html.Node for the `onclose` event handler attribute.
+//
+// The `onclose` handler runs when a dialog is closed, either by the user or by
+// calling dialog.close().
+//
+// Example Usage:
+// <dialog onclose="saveDraft()">...</dialog>
+func OnClose(script js.Script) html.Node {
+	return handler("onclose", script)
+}
+
+// OnCommand constructs an html.Node for the `oncommand` event handler attribute.
+//
+// The `oncommand` handler runs when a button whose commandfor attribute points
+// at the element is activated. The event's command property is the button's
+// command.
+//
+// Example Usage:
+// <dialog id="confirm" oncommand="handleCommand(event)">...</dialog>
+func OnCommand(script js.Script) html.Node {
+	return handler("oncommand", script)
+}
+
+// OnContextLost constructs an html.Node for the `oncontextlost` event handler attribute.
+//
+// The `oncontextlost` handler runs when the browser discards the rendering
+// context of a canvas, for example to free GPU memory.
+//
+// Example Usage:
+// <canvas oncontextlost="pauseRendering()"></canvas>
+func OnContextLost(script js.Script) html.Node {
+	return handler("oncontextlost", script)
+}
+
+// OnContextMenu constructs an html.Node for the `oncontextmenu` event handler attribute.
+//
+// The `oncontextmenu` handler runs when the user asks for the context menu,
+// usually by right clicking. Calling event.preventDefault() suppresses the
+// browser's menu.
+//
+// Example Usage:
+// <div oncontextmenu="showCustomMenu(event)">...</div>
+func OnContextMenu(script js.Script) html.Node {
+	return handler("oncontextmenu", script)
+}
+
+// OnContextRestored constructs an html.Node for the `oncontextrestored` event handler attribute.
+//
+// The `oncontextrestored` handler runs when the browser restores a canvas
+// rendering context that was previously lost.
+//
+// Example Usage:
+// <canvas oncontextrestored="redraw()"></canvas>
+func OnContextRestored(script js.Script) html.Node {
+	return handler("oncontextrestored", script)
+}
+
+// OnCopy constructs an html.Node for the `oncopy` event handler attribute.
+//
+// The `oncopy` handler runs when the user copies the current selection to the
+// clipboard.
+//
+// Example Usage:
+// <pre oncopy="trackCopy()">npm install sanity</pre>
+func OnCopy(script js.Script) html.Node {
+	return handler("oncopy", script)
+}
+
+// OnCueChange constructs an html.Node for the `oncuechange` event handler attribute.
+//
+// The `oncuechange` handler runs when the cues that are active in a text track
+// change, like when a new subtitle is shown.
+//
+// Example Usage:
+// <track src="en.vtt" kind="captions" oncuechange="syncTranscript(this)">
+func OnCueChange(script js.Script) html.Node {
+	return handler("oncuechange", script)
+}
+
+// OnCut constructs an html.Node for the `oncut` event handler attribute.
+//
+// The `oncut` handler runs when the user cuts the current selection to the
+// clipboard.
+//
+// Example Usage:
+// <textarea oncut="markDirty()"></textarea>
+func OnCut(script js.Script) html.Node {
+	return handler("oncut", script)
+}
+
+// OnDblClick constructs an html.Node for the `ondblclick` event handler attribute.
+//
+// The `ondblclick` handler runs when the user clicks the element twice in
+// quick succession.
+//
+// Example Usage:
+// <li ondblclick="startRename(this)">notes.txt</li>
+func OnDblClick(script js.Script) html.Node {
+	return handler("ondblclick", script)
+}
+
+// OnDrag constructs an html.Node for the `ondrag` event handler attribute.
+//
+// The `ondrag` handler runs when an element is being dragged. It fires every
+// few hundred milliseconds during the drag.
+//
+// Example Usage:
+// <li draggable="true" ondrag="updateGhost(event)">Item</li>
+func OnDrag(script js.Script) html.Node {
+	return handler("ondrag", script)
+}
+
+// OnDragEnd constructs an html.Node for the `ondragend` event handler attribute.
+//
+// The `ondragend` handler runs when a drag operation ends because the user
+// released the pointer or pressed Escape.
+//
+// Example Usage:
+// <li draggable="true" ondragend="clearDropTargets()">Item</li>
+func OnDragEnd(script js.Script) html.Node {
+	return handler("ondragend", script)
+}
+
+// OnDragEnter constructs an html.Node for the `ondragenter` event handler attribute.
+//
+// The `ondragenter` handler runs when a dragged item enters the element.
+// Calling event.preventDefault() marks the element as a drop target.
+//
+// Example Usage:
+// <div ondragenter="highlight(this)">Drop files here</div>
+func OnDragEnter(script js.Script) html.Node {
+	return handler("ondragenter", script)
+}
+
+// OnDragLeave constructs an html.Node for the `ondragleave` event handler attribute.
+//
+// The `ondragleave` handler runs when a dragged item leaves the element.
+//
+// Example Usage:
+// <div ondragleave="unhighlight(this)">Drop files here</div>
+func OnDragLeave(script js.Script) html.Node {
+	return handler("ondragleave", script)
+}
+
+// OnDragOver constructs an html.Node for the `ondragover` event handler attribute.
+//
+// The `ondragover` handler runs when a dragged item is moved over the element.
+// Call event.preventDefault() to allow a drop.
+//
+// Example Usage:
+// <div ondragover="event.preventDefault()">Drop files here</div>
+func OnDragOver(script js.Script) html.Node {
+	return handler("ondragover", script)
+}
+
+// OnDragStart constructs an html.Node for the `ondragstart` event handler attribute.
+//
+// The `ondragstart` handler runs when the user starts dragging the element or
+// a text selection.
+//
+// Example Usage:
+// <li draggable="true" ondragstart="startDrag(event)">Item</li>
+func OnDragStart(script js.Script) html.Node {
+	return handler("ondragstart", script)
+}
+
+// OnDrop constructs an html.Node for the `ondrop` event handler attribute.
+//
+// The `ondrop` handler runs when a dragged item is dropped on the element.
+//
+// Example Usage:
+// <div ondragover="event.preventDefault()" ondrop="upload(event)">Drop files here</div>
+func OnDrop(script js.Script) html.Node {
+	return handler("ondrop", script)
+}
+
+// OnDurationChange constructs an html.Node for the `ondurationchange` event handler attribute.
+//
+// The `ondurationchange` handler runs when the duration of a media element
+// changes, usually once the metadata has loaded.
+//
+// Example Usage:
+// <video src="talk.mp4" ondurationchange="showLength(this.duration)"></video>
+func OnDurationChange(script js.Script) html.Node {
+	return handler("ondurationchange", script)
+}
+
+// OnEmptied constructs an html.Node for the `onemptied` event handler attribute.
+//
+// The `onemptied` handler runs when a media element is reset, for example
+// because load() was called while it was playing.
+//
+// Example Usage:
+// <audio onemptied="resetPlayer()"></audio>
+func OnEmptied(script js.Script) html.Node {
+	return handler("onemptied", script)
+}
+
+// OnEnded constructs an html.Node for the `onended` event handler attribute.
+//
+// The `onended` handler runs when a media element plays to the end of its
+// resource.
+//
+// Example Usage:
+// <video src="lesson-1.mp4" onended="playNext()"></video>
+func OnEnded(script js.Script) html.Node {
+	return handler("onended", script)
+}
+
+// OnError constructs an html.Node for the `onerror` event handler attribute.
+//
+// The `onerror` handler runs when the element's resource fails to load, like
+// an image with a broken URL.
+//
+// Example Usage:
+// <img src="avatar.png" alt="" onerror="this.src='/default-avatar.png'">
+func OnError(script js.Script) html.Node {
+	return handler("onerror", script)
+}
+
+// OnFocus constructs an html.Node for the `onfocus` event handler attribute.
+//
+// The `onfocus` handler runs when the element receives focus. Unlike focusin,
+// the event does not bubble.
+//
+// Example Usage:
+// <input name="search" onfocus="showSuggestions()">
+func OnFocus(script js.Script) html.Node {
+	return handler("onfocus", script)
+}
+
+// OnFormData constructs an html.Node for the `onformdata` event handler attribute.
+//
+// The `onformdata` handler runs when a form builds the list of entries it will
+// submit, so scripts can add entries to event.formData.
+//
+// Example Usage:
+// <form onformdata="addClientTime(event)">...</form>
+func OnFormData(script js.Script) html.Node {
+	return handler("onformdata", script)
+}
+
+// OnHashChange constructs an html.Node for the `onhashchange` event handler attribute.
+//
+// The `onhashchange` handler runs when the fragment of the page's URL, the
+// part after #, changes.
+//
+// Example Usage:
+// <body onhashchange="showTab(location.hash)">...</body>
+func OnHashChange(script js.Script) html.Node {
+	return handler("onhashchange", script)
+}
+
+// OnInput constructs an html.Node for the `oninput` event handler attribute.
+//
+// The `oninput` handler runs when the value of an input, select, textarea, or
+// contenteditable element changes, once for every edit.
+//
+// Example Usage:
+// <input name="query" oninput="search(this.value)">
+func OnInput(script js.Script) html.Node {
+	return handler("oninput", script)
+}
+
+// OnInvalid constructs an html.Node for the `oninvalid` event handler attribute.
+//
+// The `oninvalid` handler runs when a form control fails constraint validation
+// when the form is submitted or checkValidity() is called.
+//
+// Example Usage:
+// <input name="email" type="email" required oninvalid="showError(this)">
+func OnInvalid(script js.Script) html.Node {
+	return handler("oninvalid", script)
+}
+
+// OnKeyDown constructs an html.Node for the `onkeydown` event handler attribute.
+//
+// The `onkeydown` handler runs when the user presses a key while the element
+// has focus. It repeats while the key is held down.
+//
+// Example Usage:
+// <input onkeydown="if (event.key === 'Escape') clearSearch()">
+func OnKeyDown(script js.Script) html.Node {
+	return handler("onkeydown", script)
+}
+
+// OnKeyPress constructs an html.Node for the `onkeypress` event handler attribute.
+//
+// The `onkeypress` handler runs when the user presses a key that produces a
+// character. The event is deprecated in favor of keydown and beforeinput.
+//
+// Example Usage:
+// <input onkeypress="countKeystrokes()">
+func OnKeyPress(script js.Script) html.Node {
+	return handler("onkeypress", script)
+}
+
+// OnKeyUp constructs an html.Node for the `onkeyup` event handler attribute.
+//
+// The `onkeyup` handler runs when the user releases a key while the element
+// has focus.
+//
+// Example Usage:
+// <textarea onkeyup="updatePreview(this.value)"></textarea>
+func OnKeyUp(script js.Script) html.Node {
+	return handler("onkeyup", script)
+}
+
+// OnLanguageChange constructs an html.Node for the `onlanguagechange` event handler attribute.
+//
+// The `onlanguagechange` handler runs when the user changes their preferred
+// languages.
+//
+// Example Usage:
+// <body onlanguagechange="relocalize(navigator.languages)">...</body>
+func OnLanguageChange(script js.Script) html.Node {
+	return handler("onlanguagechange", script)
+}
+
+// OnLoad constructs an html.Node for the `onload` event handler attribute.
+//
+// The `onload` handler runs when the element's resource has finished loading,
+// or, on <body>, the page and all of its resources have loaded.
+//
+// Example Usage:
+// <img src="photo.jpg" alt="" onload="fadeIn(this)">
+func OnLoad(script js.Script) html.Node {
+	return handler("onload", script)
+}
+
+// OnLoadedData constructs an html.Node for the `onloadeddata` event handler attribute.
+//
+// The `onloadeddata` handler runs when the frame at the current playback
+// position of a media element has loaded.
+//
+// Example Usage:
+// <video src="intro.mp4" onloadeddata="showPoster(this)"></video>
+func OnLoadedData(script js.Script) html.Node {
+	return handler("onloadeddata", script)
+}
+
+// OnLoadedMetadata constructs an html.Node for the `onloadedmetadata` event handler attribute.
+//
+// The `onloadedmetadata` handler runs when the duration and dimensions of a
+// media element are known.
+//
+// Example Usage:
+// <video src="intro.mp4" onloadedmetadata="sizePlayer(this)"></video>
+func OnLoadedMetadata(script js.Script) html.Node {
+	return handler("onloadedmetadata", script)
+}
+
+// OnLoadStart constructs an html.Node for the `onloadstart` event handler attribute.
+//
+// The `onloadstart` handler runs when a media element starts loading its
+// resource.
+//
+// Example Usage:
+// <audio src="podcast.mp3" onloadstart="showSpinner()"></audio>
+func OnLoadStart(script js.Script) html.Node {
+	return handler("onloadstart", script)
+}
+
+// OnMessage constructs an html.Node for the `onmessage` event handler attribute.
+//
+// The `onmessage` handler runs when the window receives a message from another
+// window, iframe, or worker through postMessage.
+//
+// Example Usage:
+// <body onmessage="handleMessage(event.data)">...</body>
+func OnMessage(script js.Script) html.Node {
+	return handler("onmessage", script)
+}
+
+// OnMessageError constructs an html.Node for the `onmessageerror` event handler attribute.
+//
+// The `onmessageerror` handler runs when the window receives a message that
+// can't be deserialized.
+//
+// Example Usage:
+// <body onmessageerror="reportBadMessage(event)">...</body>
+func OnMessageError(script js.Script) html.Node {
+	return handler("onmessageerror", script)
+}
+
+// OnMouseDown constructs an html.Node for the `onmousedown` event handler attribute.
+//
+// The `onmousedown` handler runs when the user presses a mouse button while
+// the pointer is over the element.
+//
+// Example Usage:
+// <div class="handle" onmousedown="startResize(event)"></div>
+func OnMouseDown(script js.Script) html.Node {
+	return handler("onmousedown", script)
+}
+
+// OnMouseEnter constructs an html.Node for the `onmouseenter` event handler attribute.
+//
+// The `onmouseenter` handler runs when the pointer moves onto the element or
+// one of its descendants. Unlike mouseover, the event does not bubble.
+//
+// Example Usage:
+// <li onmouseenter="preview(this)">Item</li>
+func OnMouseEnter(script js.Script) html.Node {
+	return handler("onmouseenter", script)
+}
+
+// OnMouseLeave constructs an html.Node for the `onmouseleave` event handler attribute.
+//
+// The `onmouseleave` handler runs when the pointer moves off of the element
+// and all of its descendants. Unlike mouseout, the event does not bubble.
+//
+// Example Usage:
+// <li onmouseleave="hidePreview()">Item</li>
+func OnMouseLeave(script js.Script) html.Node {
+	return handler("onmouseleave", script)
+}
+
+// OnMouseMove constructs an html.Node for the `onmousemove` event handler attribute.
+//
+// The `onmousemove` handler runs when the pointer moves while it is over the
+// element.
+//
+// Example Usage:
+// <canvas onmousemove="draw(event)"></canvas>
+func OnMouseMove(script js.Script) html.Node {
+	return handler("onmousemove", script)
+}
+
+// OnMouseOut constructs an html.Node for the `onmouseout` event handler attribute.
+//
+// The `onmouseout` handler runs when the pointer moves off of the element or
+// off of one of its descendants.
+//
+// Example Usage:
+// <nav onmouseout="scheduleClose()">...</nav>
+func OnMouseOut(script js.Script) html.Node {
+	return handler("onmouseout", script)
+}
+
+// OnMouseOver constructs an html.Node for the `onmouseover` event handler attribute.
+//
+// The `onmouseover` handler runs when the pointer moves onto the element or
+// onto one of its descendants.
+//
+// Example Usage:
+// <nav onmouseover="cancelClose()">...</nav>
+func OnMouseOver(script js.Script) html.Node {
+	return handler("onmouseover", script)
+}
+
+// OnMouseUp constructs an html.Node for the `onmouseup` event handler attribute.
+//
+// The `onmouseup` handler runs when the user releases a mouse button while the
+// pointer is over the element.
+//
+// Example Usage:
+// <div class="handle" onmouseup="stopResize()"></div>
+func OnMouseUp(script js.Script) html.Node {
+	return handler("onmouseup", script)
+}
+
+// OnOffline constructs an html.Node for the `onoffline` event handler attribute.
+//
+// The `onoffline` handler runs when the browser loses network access.
+//
+// Example Usage:
+// <body onoffline="showOfflineBanner()">...</body>
+func OnOffline(script js.Script) html.Node {
+	return handler("onoffline", script)
+}
+
+// OnOnline constructs an html.Node for the `ononline` event handler attribute.
+//
+// The `ononline` handler runs when the browser regains network access.
+//
+// Example Usage:
+// <body ononline="hideOfflineBanner()">...</body>
+func OnOnline(script js.Script) html.Node {
+	return handler("ononline", script)
+}
+
+// OnPageHide constructs an html.Node for the `onpagehide` event handler attribute.
+//
+// The `onpagehide` handler runs when the user navigates away from the page,
+// which may be kept in the back/forward cache.
+//
+// Example Usage:
+// <body onpagehide="saveState()">...</body>
+func OnPageHide(script js.Script) html.Node {
+	return handler("onpagehide", script)
+}
+
+// OnPageReveal constructs an html.Node for the `onpagereveal` event handler attribute.
+//
+// The `onpagereveal` handler runs when the page is displayed for the first
+// time or restored from the back/forward cache, before its first rendering.
+//
+// Example Usage:
+// <body onpagereveal="startViewTransition(event)">...</body>
+func OnPageReveal(script js.Script) html.Node {
+	return handler("onpagereveal", script)
+}
+
+// OnPageShow constructs an html.Node for the `onpageshow` event handler attribute.
+//
+// The `onpageshow` handler runs when the page is shown, including when it is
+// restored from the back/forward cache.
+//
+// Example Usage:
+// <body onpageshow="if (event.persisted) refreshCart()">...</body>
+func OnPageShow(script js.Script) html.Node {
+	return handler("onpageshow", script)
+}
+
+// OnPageSwap constructs an html.Node for the `onpageswap` event handler attribute.
+//
+// The `onpageswap` handler runs when the page is about to be replaced by a
+// same-origin navigation, before its last rendering.
+//
+// Example Usage:
+// <body onpageswap="prepareViewTransition(event)">...</body>
+func OnPageSwap(script js.Script) html.Node {
+	return handler("onpageswap", script)
+}
+
+// OnPaste constructs an html.Node for the `onpaste` event handler attribute.
+//
+// The `onpaste` handler runs when the user pastes content from the clipboard
+// into the element.
+//
+// Example Usage:
+// <input name="code" onpaste="trimPasted(event)">
+func OnPaste(script js.Script) html.Node {
+	return handler("onpaste", script)
+}
+
+// OnPause constructs an html.Node for the `onpause` event handler attribute.
+//
+// The `onpause` handler runs when a media element is paused.
+//
+// Example Usage:
+// <video src="intro.mp4" onpause="saveProgress(this.currentTime)"></video>
+func OnPause(script js.Script) html.Node {
+	return handler("onpause", script)
+}
+
+// OnPlay constructs an html.Node for the `onplay` event handler attribute.
+//
+// The `onplay` handler runs when a media element starts playing or play() is
+// called.
+//
+// Example Usage:
+// <audio src="song.mp3" onplay="pauseOtherPlayers(this)"></audio>
+func OnPlay(script js.Script) html.Node {
+	return handler("onplay", script)
+}
+
+// OnPlaying constructs an html.Node for the `onplaying` event handler attribute.
+//
+// The `onplaying` handler runs when a media element actually starts playing,
+// including after it stopped to buffer.
+//
+// Example Usage:
+// <video src="live.m3u8" onplaying="hideSpinner()"></video>
+func OnPlaying(script js.Script) html.Node {
+	return handler("onplaying", script)
+}
+
+// OnPopState constructs an html.Node for the `onpopstate` event handler attribute.
+//
+// The `onpopstate` handler runs when the user navigates through the session
+// history, for example with the back button.
+//
+// Example Usage:
+// <body onpopstate="render(event.state)">...</body>
+func OnPopState(script js.Script) html.Node {
+	return handler("onpopstate", script)
+}
+
+// OnProgress constructs an html.Node for the `onprogress` event handler attribute.
+//
+// The `onprogress` handler runs when the browser loads more of a media
+// element's resource.
+//
+// Example Usage:
+// <video src="intro.mp4" onprogress="updateBuffered(this)"></video>
+func OnProgress(script js.Script) html.Node {
+	return handler("onprogress", script)
+}
+
+// OnRateChange constructs an html.Node for the `onratechange` event handler attribute.
+//
+// The `onratechange` handler runs when the playback rate of a media element
+// changes.
+//
+// Example Usage:
+// <video src="talk.mp4" onratechange="showSpeed(this.playbackRate)"></video>
+func OnRateChange(script js.Script) html.Node {
+	return handler("onratechange", script)
+}
+
+// OnRejectionHandled constructs an html.Node for the `onrejectionhandled` event handler attribute.
+//
+// The `onrejectionhandled` handler runs when a handler is attached to a
+// promise whose rejection was previously unhandled.
+//
+// Example Usage:
+// <body onrejectionhandled="clearRejection(event.promise)">...</body>
+func OnRejectionHandled(script js.Script) html.Node {
+	return handler("onrejectionhandled", script)
+}
+
+// OnReset constructs an html.Node for the `onreset` event handler attribute.
+//
+// The `onreset` handler runs when a form is reset to its initial values.
+//
+// Example Usage:
+// <form onreset="clearErrors()">...</form>
+func OnReset(script js.Script) html.Node {
+	return handler("onreset", script)
+}
+
+// OnResize constructs an html.Node for the `onresize` event handler attribute.
+//
+// The `onresize` handler runs when the viewport is resized. It is usually set
+// on <body>.
+//
+// Example Usage:
+// <body onresize="relayout()">...</body>
+func OnResize(script js.Script) html.Node {
+	return handler("onresize", script)
+}
+
+// OnScroll constructs an html.Node for the `onscroll` event handler attribute.
+//
+// The `onscroll` handler runs when the element, or the document when set on
+// <body>, is scrolled.
+//
+// Example Usage:
+// <div class="feed" onscroll="loadMoreIfNeeded(this)">...</div>
+func OnScroll(script js.Script) html.Node {
+	return handler("onscroll", script)
+}
+
+// OnScrollEnd constructs an html.Node for the `onscrollend` event handler attribute.
+//
+// The `onscrollend` handler runs when the element, or the document when set on
+// <body>, stops scrolling.
+//
+// Example Usage:
+// <div class="carousel" onscrollend="updateDots(this)">...</div>
+func OnScrollEnd(script js.Script) html.Node {
+	return handler("onscrollend", script)
+}
+
+// OnSecurityPolicyViolation constructs an html.Node for the `onsecuritypolicyviolation` event handler attribute.
+//
+// The `onsecuritypolicyviolation` handler runs when the element triggers a
+// content security policy violation.
+//
+// Example Usage:
+// <body onsecuritypolicyviolation="reportViolation(event)">...</body>
+func OnSecurityPolicyViolation(script js.Script) html.Node {
+	return handler("onsecuritypolicyviolation", script)
+}
+
+// OnSeeked constructs an html.Node for the `onseeked` event handler attribute.
+//
+// The `onseeked` handler runs when a media element finishes seeking to a new
+// playback position.
+//
+// Example Usage:
+// <video src="talk.mp4" onseeked="syncSlides(this.currentTime)"></video>
+func OnSeeked(script js.Script) html.Node {
+	return handler("onseeked", script)
+}
+
+// OnSeeking constructs an html.Node for the `onseeking` event handler attribute.
+//
+// The `onseeking` handler runs when a media element starts seeking to a new
+// playback position.
+//
+// Example Usage:
+// <video src="talk.mp4" onseeking="showSpinner()"></video>
+func OnSeeking(script js.Script) html.Node {
+	return handler("onseeking", script)
+}
+
+// OnSelect constructs an html.Node for the `onselect` event handler attribute.
+//
+// The `onselect` handler runs when the user selects text in an input or
+// textarea.
+//
+// Example Usage:
+// <textarea onselect="showFormattingToolbar()"></textarea>
+func OnSelect(script js.Script) html.Node {
+	return handler("onselect", script)
+}
+
+// OnSlotChange constructs an html.Node for the `onslotchange` event handler attribute.
+//
+// The `onslotchange` handler runs when the nodes assigned to a <slot> in a
+// shadow tree change.
+//
+// Example Usage:
+// <slot name="items" onslotchange="countItems(this)"></slot>
+func OnSlotChange(script js.Script) html.Node {
+	return handler("onslotchange", script)
+}
+
+// OnStalled constructs an html.Node for the `onstalled` event handler attribute.
+//
+// The `onstalled` handler runs when a media element is trying to load data,
+// but the data is unexpectedly not arriving.
+//
+// Example Usage:
+// <video src="intro.mp4" onstalled="showNetworkWarning()"></video>
+func OnStalled(script js.Script) html.Node {
+	return handler("onstalled", script)
+}
+
+// OnStorage constructs an html.Node for the `onstorage` event handler attribute.
+//
+// The `onstorage` handler runs when another document with the same origin
+// changes local storage.
+//
+// Example Usage:
+// <body onstorage="syncSettings(event.key)">...</body>
+func OnStorage(script js.Script) html.Node {
+	return handler("onstorage", script)
+}
+
+// OnSubmit constructs an html.Node for the `onsubmit` event handler attribute.
+//
+// The `onsubmit` handler runs when a form is submitted. Calling
+// event.preventDefault() stops the submission.
+//
+// Example Usage:
+// <form onsubmit="return confirmOrder()">...</form>
+func OnSubmit(script js.Script) html.Node {
+	return handler("onsubmit", script)
+}
+
+// OnSuspend constructs an html.Node for the `onsuspend` event handler attribute.
+//
+// The `onsuspend` handler runs when a media element stops loading its resource
+// before it finished, usually because it has buffered enough.
+//
+// Example Usage:
+// <video src="intro.mp4" preload="metadata" onsuspend="logSuspend()"></video>
+func OnSuspend(script js.Script) html.Node {
+	return handler("onsuspend", script)
+}
+
+// OnTimeUpdate constructs an html.Node for the `ontimeupdate` event handler attribute.
+//
+// The `ontimeupdate` handler runs when the current playback position of a
+// media element changes, several times a second while it plays.
+//
+// Example Usage:
+// <audio src="song.mp3" ontimeupdate="updateProgressBar(this)"></audio>
+func OnTimeUpdate(script js.Script) html.Node {
+	return handler("ontimeupdate", script)
+}
+
+// OnToggle constructs an html.Node for the `ontoggle` event handler attribute.
+//
+// The `ontoggle` handler runs when a details element, popover, or dialog has
+// been opened or closed.
+//
+// Example Usage:
+// <details ontoggle="rememberOpen(this)">...</details>
+func OnToggle(script js.Script) html.Node {
+	return handler("ontoggle", script)
+}
+
+// OnUnhandledRejection constructs an html.Node for the `onunhandledrejection` event handler attribute.
+//
+// The `onunhandledrejection` handler runs when a promise is rejected and
+// nothing handles the rejection.
+//
+// Example Usage:
+// <body onunhandledrejection="reportError(event.reason)">...</body>
+func OnUnhandledRejection(script js.Script) html.Node {
+	return handler("onunhandledrejection", script)
+}
+
+// OnUnload constructs an html.Node for the `onunload` event handler attribute.
+//
+// The `onunload` handler runs when the page is being unloaded. The event is
+// unreliable and prevents the back/forward cache, so prefer PageHide.
+//
+// Example Usage:
+// <body onunload="sendBeacon()">...</body>
+func OnUnload(script js.Script) html.Node {
+	return handler("onunload", script)
+}
+
+// OnVolumeChange constructs an html.Node for the `onvolumechange` event handler attribute.
+//
+// The `onvolumechange` handler runs when the volume or the muted state of a
+// media element changes.
+//
+// Example Usage:
+// <video src="intro.mp4" onvolumechange="saveVolume(this.volume)"></video>
+func OnVolumeChange(script js.Script) html.Node {
+	return handler("onvolumechange", script)
+}
+
+// OnWaiting constructs an html.Node for the `onwaiting` event handler attribute.
+//
+// The `onwaiting` handler runs when a media element stops playing because it
+// needs to buffer more data.
+//
+// Example Usage:
+// <video src="intro.mp4" onwaiting="showSpinner()"></video>
+func OnWaiting(script js.Script) html.Node {
+	return handler("onwaiting", script)
+}
+
+// OnWheel constructs an html.Node for the `onwheel` event handler attribute.
+//
+// The `onwheel` handler runs when the user rotates the mouse wheel or scrolls
+// with a trackpad over the element.
+//
+// Example Usage:
+// <div class="map" onwheel="zoom(event)"></div>
+func OnWheel(script js.Script) html.Node {
+	return handler("onwheel", script)
+}
@@ -3,21 +3,33 @@ package html
 import "html"
 
 // NewAttribute creates an attribute with a value. Like id="some-id" or
-// class="class-a class-b".
+// class="class-a class-b". The value is escaped. If the name is not a valid
+// attribute name, NewAttribute returns a node that renders as nothing. See
+// ValidateAttributeName for the rules.
 func NewAttribute(name string, value string) Node {
+	if err := ValidateAttributeName(name); err != nil {
+		return Invalid(err)
+	}
+	// Valid names contain no characters that need to be escaped.
 	return Node{
 		nodeType: nodeTypeAttr,
-		str1:     html.EscapeString(name),
+		str1:     name,
 		str2:     html.EscapeString(value),
+		children: withCallSite(nil),
 	}
 }
 
 // NewBoolAttribute creates a bool attribute. A bool attribute is an attribute
 // with no value. An example bool attribute is the `disabled` attribute in
-// <button disabled>Submit</button>.
+// <button disabled>Submit</button>. If the name is not a valid attribute
+// name, NewBoolAttribute returns a node that renders as nothing.
 func NewBoolAttribute(name string) Node {
+	if err := ValidateAttributeName(name); err != nil {
+		return Invalid(err)
+	}
 	return Node{
 		nodeType: nodeTypeBoolAttr,
-		str1:     html.EscapeString(name),
+		str1:     name,
+		children: withCallSite(nil),
 	}
 }
@@ -15,7 +15,8 @@ func TestNewAttribute(t *testing.T) {
 	tests := []testCase{
 		{"id", "", `<div id=""></div>`},
 		{"class", "foo class", `<div class="foo class"></div>`},
-		{"escape<test", "\"", `<div escape&lt;test="&#34;"></div>`},
+		{"escape", "\"<>", `<div escape="&#34;&lt;&gt;"></div>`},
+		{"escape<test", "\"", `<div></div>`},
 	}
 	for _, tc := range tests {
 		node := NewAttribute(tc.attribute, tc.value)
@@ -31,7 +32,7 @@ func TestBoolAttribute(t *testing.T) {
 	tests := []testCase{
 		{"async", `<link async>`},
 		{"default", `<link default>`},
-		{"escape<test", `<link escape&lt;test>`},
+		{"escape<test", `<link>`},
 	}
 	for _, tc := range tests {
 		node := NewBoolAttribute(tc.attribute)
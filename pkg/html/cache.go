@@ -0,0 +1,59 @@
+package html
+
+import "time"
+
+// Cache stores the rendered bytes of Cached nodes. Implementations must be
+// safe for concurrent use. LRUCache is the default implementation, but a
+// Cache backed by something like memcached can be plugged in with the
+// WithCache render option.
+type Cache interface {
+	// Get returns the bytes stored for the key. The caller must not modify
+	// the returned bytes.
+	Get(key string) ([]byte, bool)
+	// Set stores the bytes for the key. The entry expires after the ttl. A
+	// ttl of zero means the entry never expires. The cache takes ownership
+	// of value.
+	Set(key string, value []byte, ttl time.Duration)
+	// InvalidatePrefix removes every entry with a key that starts with the
+	// prefix.
+	InvalidatePrefix(prefix string)
+	// Stats returns counters that describe how effective the cache is.
+	// Counters the implementation doesn't track are zero.
+	Stats() CacheStats
+}
+
+// DefaultCache is the cache used by Cached nodes unless the WithCache render
+// option is used. It holds up to 32MiB of rendered fragments. Its hit and miss
+// counters are available with DefaultCache.Stats().
+var DefaultCache Cache = NewLRUCache(32 << 20)
+
+// Cached renders the node returned by build once and reuses the rendered
+// bytes until the ttl expires. The key must identify everything the fragment
+// depends on, since build is not called for cache hits. Fragments are
+// rendered in their own buffer, so Flush nodes inside of a fragment are
+// ignored, and fragments that fail are not stored.
+//
+// Example Usage:
+//
+//	card := Cached("article/"+article.ID, time.Minute, func() Node {
+//		return articleView(article)
+//	})
+//
+//	// After the article is edited:
+//	DefaultCache.InvalidatePrefix("article/" + article.ID)
+func Cached(key string, ttl time.Duration, build func() Node) Node {
+	return Node{
+		nodeType: nodeTypeCached,
+		str1:     key,
+		children: withPayload(Node{}, cachedFragment{
+			ttl:   ttl,
+			build: build,
+		}),
+	}
+}
+
+// cachedFragment is the payload of a Cached node.
+type cachedFragment struct {
+	ttl   time.Duration
+	build func() Node
+}
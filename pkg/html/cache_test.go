@@ -0,0 +1,91 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCached(t *testing.T) {
+	cache := NewLRUCache(1024)
+	builds := 0
+	view := func(name string) Node {
+		return Cached("greeting", time.Minute, func() Node {
+			builds++
+			return NewTag("p", InnerText("hello "+name))
+		})
+	}
+
+	for _, name := range []string{"first", "second"} {
+		var buffer bytes.Buffer
+		require.NoError(t, NewTag("div", view(name)).RenderTo(&buffer, WithCache(cache)))
+		// The second render reuses the bytes from the first render.
+		require.Equal(t, "<div><p>hello first</p></div>", buffer.String())
+	}
+	require.Equal(t, 1, builds)
+	require.Equal(t, uint64(1), cache.Stats().Hits)
+
+	cache.InvalidatePrefix("greet")
+	var buffer bytes.Buffer
+	require.NoError(t, view("third").RenderTo(&buffer, WithCache(cache)))
+	require.Equal(t, "<p>hello third</p>", buffer.String())
+	require.Equal(t, 2, builds)
+}
+
+func TestCachedDefaultCache(t *testing.T) {
+	defer DefaultCache.InvalidatePrefix("TestCachedDefaultCache")
+	before := DefaultCache.Stats()
+	builds := 0
+	node := Cached("TestCachedDefaultCache", 0, func() Node {
+		builds++
+		return InnerText("cached")
+	})
+	require.Equal(t, "cached", node.String())
+	require.Equal(t, "cached", node.String())
+	require.Equal(t, 1, builds)
+	after := DefaultCache.Stats()
+	require.Equal(t, before.Hits+1, after.Hits)
+	require.Equal(t, before.Misses+1, after.Misses)
+}
+
+func TestCachedError(t *testing.T) {
+	cache := NewLRUCache(1024)
+	node := Cached("error", time.Minute, func() Node {
+		return NewTag("div", failingView())
+	})
+	err := node.RenderTo(&bytes.Buffer{}, WithCache(cache))
+	require.ErrorIs(t, err, errView)
+	require.Equal(t, 0, cache.Stats().Entries)
+
+	bytes, err := ErrorBoundary(fallbackView, node).RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "<p>fallback: view failed</p>", string(bytes))
+}
+
+func TestCachedSkippedError(t *testing.T) {
+	defer DefaultCache.InvalidatePrefix("TestCachedSkippedError")
+	builds := 0
+	node := Cached("TestCachedSkippedError", time.Minute, func() Node {
+		builds++
+		return NewTag("div", failingView())
+	})
+	// Render skips the failed Try, but the broken output is not stored.
+	require.Equal(t, "<div></div>", node.String())
+	require.Equal(t, "<div></div>", node.String())
+	require.Equal(t, 2, builds)
+
+	// The fallback of an ErrorBoundary inside of the fragment is not stored
+	// either.
+	cache := NewLRUCache(1024)
+	node = Cached("boundary", time.Minute, func() Node {
+		builds++
+		return ErrorBoundary(fallbackView, failingView())
+	})
+	var buffer bytes.Buffer
+	require.NoError(t, node.RenderTo(&buffer, WithCache(cache)))
+	require.Equal(t, "<p>fallback: view failed</p>", buffer.String())
+	require.Equal(t, 0, cache.Stats().Entries)
+}
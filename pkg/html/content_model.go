@@ -0,0 +1,156 @@
+package html
+
+import "github.com/jeffswenson/sanity/pkg/spec"
+
+// The tables in this file are a simplified copy of the content models
+// defined by the WHATWG HTML standard. They are used by Validate. The list of
+// elements comes from pkg/spec.
+
+// knownElements contains every element defined by the HTML standard,
+// including obsolete elements that browsers still support.
+var knownElements = elementsWhere(func(spec.Element) bool { return true })
+
+// foreignElements contains every SVG and MathML element. Foreign element
+// names are case sensitive.
+var foreignElements = foreignElementSet(spec.SVGElements(), spec.MathMLElements())
+
+// mathMLElements contains every MathML element. The validator uses it to
+// tell MathML elements apart from SVG elements.
+var mathMLElements = foreignElementSet(spec.MathMLElements())
+
+// svgIntegrationPoints are the SVG elements whose children are HTML.
+var svgIntegrationPoints = []string{"foreignObject", "desc", "title"}
+
+// mathMLIntegrationPoints are the MathML elements whose children may be
+// HTML. The children of <annotation-xml> are only HTML if its encoding is
+// text/html or application/xhtml+xml.
+var mathMLIntegrationPoints = []string{"mi", "mo", "mn", "ms", "mtext", "annotation-xml"}
+
+// voidElements have no closing tag and no children.
+var voidElements = elementsWhere(func(e spec.Element) bool { return e.Void })
+
+// phrasingElements are the elements that are phrasing content. Phrasing
+// content is the text of the document and the elements that mark it up.
+var phrasingElements = setOf(
+	"a", "abbr", "area", "audio", "b", "bdi", "bdo", "br", "button",
+	"canvas", "cite", "code", "data", "datalist", "del", "dfn", "em",
+	"embed", "i", "iframe", "img", "input", "ins", "kbd", "label", "link",
+	"map", "mark", "math", "meta", "meter", "noscript", "object", "output",
+	"picture", "progress", "q", "ruby", "s", "samp", "script", "select",
+	"slot", "small", "span", "strong", "sub", "sup", "svg", "template",
+	"textarea", "time", "u", "var", "video", "wbr",
+	// Obsolete
+	"acronym", "big", "font", "strike", "tt",
+)
+
+// phrasingOnlyElements may only contain phrasing content.
+var phrasingOnlyElements = setOf(
+	"abbr", "b", "bdi", "bdo", "button", "cite", "code", "data", "dfn",
+	"em", "h1", "h2", "h3", "h4", "h5", "h6", "i", "kbd", "label", "legend",
+	"mark", "meter", "output", "p", "pre", "progress", "q", "s", "samp",
+	"small", "span", "strong", "sub", "sup", "time", "u", "var",
+	// Obsolete
+	"acronym", "big", "font", "strike", "tt",
+)
+
+// transparentElements have the content model of their parent.
+var transparentElements = setOf(
+	"a", "audio", "canvas", "del", "ins", "map", "noscript", "object",
+	"slot", "video",
+)
+
+// textOnlyElements may only contain text.
+var textOnlyElements = setOf(
+	"option", "script", "style", "textarea", "title",
+)
+
+// scriptSupportingElements are allowed wherever a parent restricts its
+// children.
+var scriptSupportingElements = setOf("script", "template")
+
+// permittedParents lists the elements that may only appear inside of
+// specific parents.
+var permittedParents = map[string][]string{
+	"body":       {"html"},
+	"caption":    {"table"},
+	"col":        {"colgroup", "table"},
+	"colgroup":   {"table"},
+	"dd":         {"dl"},
+	"dt":         {"dl"},
+	"figcaption": {"figure"},
+	"head":       {"html"},
+	"legend":     {"fieldset"},
+	"li":         {"ul", "ol", "menu"},
+	"optgroup":   {"select"},
+	"option":     {"select", "datalist", "optgroup"},
+	"rp":         {"ruby"},
+	"rt":         {"ruby"},
+	"source":     {"audio", "video", "picture"},
+	"summary":    {"details"},
+	"tbody":      {"table"},
+	"td":         {"tr"},
+	"tfoot":      {"table"},
+	"th":         {"tr"},
+	"thead":      {"table"},
+	"title":      {"head", "svg"},
+	"tr":         {"table", "thead", "tbody", "tfoot"},
+	"track":      {"audio", "video"},
+}
+
+// permittedChildren lists the elements that may only contain specific
+// children. Script supporting elements are always permitted.
+var permittedChildren = map[string][]string{
+	"colgroup": {"col"},
+	"dl":       {"dt", "dd", "div"},
+	"head":     {"base", "link", "meta", "noscript", "style", "title"},
+	"html":     {"head", "body"},
+	"menu":     {"li"},
+	"ol":       {"li"},
+	"optgroup": {"option"},
+	"select":   {"option", "optgroup", "hr"},
+	"table":    {"caption", "colgroup", "thead", "tbody", "tfoot", "tr"},
+	"tbody":    {"tr"},
+	"tfoot":    {"tr"},
+	"thead":    {"tr"},
+	"tr":       {"td", "th"},
+	"ul":       {"li"},
+}
+
+// requiredAttributes lists attributes that must be present on an element.
+var requiredAttributes = map[string][]string{
+	"bdo":      {"dir"},
+	"img":      {"src", "alt"},
+	"map":      {"name"},
+	"optgroup": {"label"},
+	"track":    {"src"},
+}
+
+func setOf(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
+
+func foreignElementSet(elements ...[]spec.Element) map[string]bool {
+	set := map[string]bool{}
+	for _, namespace := range elements {
+		for _, element := range namespace {
+			set[element.Name] = true
+		}
+	}
+	return set
+}
+
+// elementsWhere returns the set of elements in pkg/spec that match the
+// predicate.
+func elementsWhere(predicate func(spec.Element) bool) map[string]bool {
+	set := map[string]bool{}
+	for _, element := range spec.Elements() {
+		if predicate(element) {
+			set[element.Name] = true
+		}
+	}
+	return set
+}
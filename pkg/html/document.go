@@ -6,9 +6,17 @@ package html
 // render as:
 // <!DOCTYPE html>
 // <html lang="en">Hello world!</html>
+//
+// Document moves the HeadContent rendered inside of it into the <head>. See
+// HeadContent.
 func Document(options ...Node) Node {
-	return Combine(
-		NewVoidTag("!DOCTYPE", NewBoolAttribute("html")),
-		NewTag("html", options...),
-	)
+	return Node{
+		nodeType: nodeTypeDocument,
+		children: []Node{Doctype(), NewTag("html", options...)},
+	}
+}
+
+// Doctype renders <!DOCTYPE html>. Most views should use Document instead.
+func Doctype() Node {
+	return newVoidTag("!DOCTYPE", NewBoolAttribute("html"))
 }
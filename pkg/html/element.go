@@ -1,20 +1,52 @@
 package html
 
 // NewTag creates an element that has a closing tag. Like <div> or </button>.
+// If the name is not a valid tag name, NewTag returns a node that renders as
+// nothing. See ValidateTagName for the rules.
 func NewTag(name string, options ...Node) Node {
+	if err := ValidateTagName(name); err != nil {
+		return Invalid(err)
+	}
 	return Node{
 		nodeType: nodeTypeTag,
 		str1:     name,
-		children: options,
+		children: withCallSite(options),
 	}
 }
 
 // NewVoidTag creates an element that has no closing tag. Like <img> or
-// <input>.
+// <input>. If the name is not a valid tag name, NewVoidTag returns a node
+// that renders as nothing. See ValidateTagName for the rules.
 func NewVoidTag(name string, options ...Node) Node {
+	if err := ValidateTagName(name); err != nil {
+		return Invalid(err)
+	}
+	return newVoidTag(name, options...)
+}
+
+// NewForeignTag creates an element in foreign content, like the SVG and
+// MathML elements in pkg/svg and pkg/mathml. Foreign elements without content
+// are self-closing, like <circle r="4"/>, and names are case sensitive, like
+// linearGradient. Names may contain hyphens, like annotation-xml, without
+// following the rules for custom element names. If the name is not a valid
+// tag name, NewForeignTag returns a node that renders as nothing.
+func NewForeignTag(name string, options ...Node) Node {
+	if err := validateForeignTagName(name); err != nil {
+		return Invalid(err)
+	}
+	return Node{
+		nodeType: nodeTypeForeignTag,
+		str1:     name,
+		children: withCallSite(options),
+	}
+}
+
+// newVoidTag creates a void tag without validating the name. It is used by
+// Doctype.
+func newVoidTag(name string, options ...Node) Node {
 	return Node{
 		nodeType: nodeTypeVoidTag,
 		str1:     name,
-		children: options,
+		children: withCallSite(options),
 	}
 }
@@ -1,6 +1,7 @@
 package html
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,3 +26,31 @@ func TestNewVoidTag(t *testing.T) {
 		NewTag("ignored"),
 	).String())
 }
+
+func TestNewForeignTag(t *testing.T) {
+	require.Equal(t, `<circle r="4"/>`, NewForeignTag("circle", NewAttribute("r", "4")).String())
+	require.Equal(t,
+		`<svg viewBox="0 0 8 8"><linearGradient id="g"><stop offset="0"/></linearGradient></svg>`,
+		NewForeignTag("svg",
+			NewAttribute("viewBox", "0 0 8 8"),
+			NewForeignTag("linearGradient", Combine(NewAttribute("id", "g"), Node{}),
+				NewForeignTag("stop", NewAttribute("offset", "0")),
+			),
+		).String(),
+	)
+	require.Equal(t, `<text>A &amp; B</text>`, NewForeignTag("text", InnerText("A & B")).String())
+	// Lazy children count as content, even if they render nothing.
+	require.Equal(t, `<g></g>`, NewForeignTag("g", Func(func(context.Context) Node { return Node{} })).String())
+	require.Equal(t, "", NewForeignTag("bad name").String())
+}
+
+func TestNewForeignTagHyphenatedNames(t *testing.T) {
+	// Foreign names are not custom element names, so reserved names and
+	// upper case letters after a hyphen are allowed.
+	require.Equal(t, `<annotation-xml></annotation-xml>`, NewForeignTag("annotation-xml", InnerText("")).String())
+	require.Equal(t, `<font-face/>`, NewForeignTag("font-face").String())
+	require.Equal(t, `<x-Shape/>`, NewForeignTag("x-Shape").String())
+	require.Equal(t, "", NewForeignTag("x_shape").String())
+	require.Equal(t, "", NewForeignTag("-shape").String())
+	require.Equal(t, "", NewTag("annotation-xml").String())
+}
@@ -0,0 +1,159 @@
+package html
+
+import (
+	"html"
+	"strings"
+
+	"github.com/jeffswenson/sanity/internal/elementpath"
+)
+
+// element is an element in the tree built by elementTree. Selectors and the
+// validator need to walk up the tree and across siblings, which the visitor
+// API can't do, so the tree is built once per query or validation.
+type element struct {
+	// name is lower case, even for foreign elements, because selectors
+	// match tag names case insensitively.
+	name       string
+	node       *Node
+	attributes map[string]string
+	parent     *element
+	children   []*element
+	// index is the element's position in parent.children.
+	index int
+	// hasText is true if the element contains text. hasVisibleText is true
+	// if the text contains something other than whitespace.
+	hasText        bool
+	hasVisibleText bool
+}
+
+// isRoot returns true for the synthetic element that is the parent of the
+// top level elements in the queried node.
+func (e *element) isRoot() bool {
+	return e.parent == nil
+}
+
+func (e *element) attribute(name string) (string, bool) {
+	value, ok := e.attributes[name]
+	return value, ok
+}
+
+func (e *element) previousSibling() *element {
+	if e.index == 0 {
+		return nil
+	}
+	return e.parent.children[e.index-1]
+}
+
+// path describes the location of the element in the tree, e.g.
+// `html>body>div[2]>p`. Elements that share their name with a sibling are
+// numbered starting from 1.
+func (e *element) path() string {
+	if e.isRoot() {
+		return ""
+	}
+	names := make([]string, len(e.parent.children))
+	for i, sibling := range e.parent.children {
+		names[i] = sibling.pathName()
+	}
+	return elementpath.Join(e.parent.path(), elementpath.Segment(names, e.index))
+}
+
+// pathName is the name of the element in paths. Foreign element names are
+// case sensitive, like foreignObject, so they keep their original case.
+func (e *element) pathName() string {
+	if e.node.nodeType == nodeTypeForeignTag {
+		return e.node.str1
+	}
+	return e.name
+}
+
+// position returns the 1-based position of the element among its siblings.
+// If ofType is true, only siblings with the same name are counted.
+func (e *element) position(fromEnd bool, ofType bool) int {
+	siblings := e.parent.children
+	position := 0
+	for i := range siblings {
+		if fromEnd {
+			i = len(siblings) - 1 - i
+		}
+		if !ofType || siblings[i].name == e.name {
+			position++
+		}
+		if siblings[i] == e {
+			return position
+		}
+	}
+	return position
+}
+
+// elementTree is a TagVisitor that records every element in the Node tree.
+type elementTree struct {
+	current  *element
+	elements []*element
+}
+
+func newElementTree(node Node) *elementTree {
+	tree := &elementTree{current: &element{}}
+	node.Visit(tree)
+	return tree
+}
+
+func (tree *elementTree) Tag(name string, node *Node) {
+	e := tree.add(name, node)
+	if e == nil {
+		return
+	}
+	parent := tree.current
+	tree.current = e
+	node.VisitChildren(tree)
+	tree.current = parent
+}
+
+func (tree *elementTree) VoidTag(name string, node *Node) {
+	tree.add(name, node)
+}
+
+func (tree *elementTree) Content(content string) {
+	if content != "" {
+		tree.current.hasText = true
+	}
+	if strings.TrimSpace(content) != "" {
+		tree.current.hasVisibleText = true
+	}
+}
+
+func (tree *elementTree) add(name string, node *Node) *element {
+	if strings.HasPrefix(name, "!") {
+		// Skip <!DOCTYPE html>.
+		return nil
+	}
+	attributes := elementAttributes{}
+	node.VisitAttributes(attributes)
+	e := &element{
+		name:       strings.ToLower(name),
+		node:       node,
+		attributes: attributes,
+		parent:     tree.current,
+		index:      len(tree.current.children),
+	}
+	tree.current.children = append(tree.current.children, e)
+	tree.elements = append(tree.elements, e)
+	return e
+}
+
+// elementAttributes collects a tag's attributes. The values are unescaped so
+// selectors can be written in terms of the original strings.
+type elementAttributes map[string]string
+
+func (attributes elementAttributes) Attribute(name string, value *string) {
+	name = strings.ToLower(html.UnescapeString(name))
+	if _, ok := attributes[name]; ok {
+		// Like browsers, the first occurrence of an attribute wins.
+		return
+	}
+	if value == nil {
+		attributes[name] = ""
+	} else {
+		attributes[name] = html.UnescapeString(*value)
+	}
+}
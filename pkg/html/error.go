@@ -0,0 +1,66 @@
+package html
+
+import "context"
+
+// Try creates a lazy node from a view that may fail. The view is called when
+// the node is rendered. If the view returns an error, rendering stops and
+// Node.RenderContext or Node.RenderTo return the error, unless the node is
+// inside of an ErrorBoundary.
+//
+// Example Usage:
+//
+//	profile := Try(func() (Node, error) {
+//		user, err := loadUser(id)
+//		if err != nil {
+//			return Node{}, err
+//		}
+//		return userView(user), nil
+//	})
+func Try(view func() (Node, error)) Node {
+	return Node{
+		nodeType: nodeTypeTry,
+		children: withPayload(Node{}, view),
+	}
+}
+
+// ErrorBoundary renders the child. If a Try node inside of the child fails or
+// a lazy node inside of the child panics, the child's output is discarded and
+// the node returned by fallback is rendered in its place. Output is not
+// flushed while an ErrorBoundary is being rendered.
+//
+// The child is built before ErrorBoundary is called, so a panic while
+// building it, like a panic in a view passed to ForEach, can't be recovered by
+// the boundary. Use ErrorBoundaryFunc to build the child inside of the
+// boundary.
+//
+// Example Usage:
+//
+//	sidebar := ErrorBoundary(func(err error) Node {
+//		return tag.Div(InnerText("recommendations are unavailable"))
+//	}, Try(func() (Node, error) {
+//		return recommendationsView(userID)
+//	}))
+func ErrorBoundary(fallback func(err error) Node, child Node) Node {
+	return Node{
+		nodeType: nodeTypeErrorBoundary,
+		children: withPayload(child, fallback),
+	}
+}
+
+// ErrorBoundaryFunc is like ErrorBoundary, but the child is built by calling
+// view when the node is rendered. A panic while building the child, like a
+// panic in a view passed to ForEach, is recovered and the node returned by
+// fallback is rendered instead.
+//
+// Example Usage:
+//
+//	sidebar := ErrorBoundaryFunc(func(err error) Node {
+//		return tag.Div(InnerText("recommendations are unavailable"))
+//	}, func() Node {
+//		return tag.Ul(ForEach(recommendations, recommendationView))
+//	})
+func ErrorBoundaryFunc(fallback func(err error) Node, view func() Node) Node {
+	return ErrorBoundary(fallback, Func(func(ctx context.Context) Node {
+		return view()
+	}))
+}
@@ -0,0 +1,135 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errView = errors.New("view failed")
+
+func failingView() Node {
+	return Try(func() (Node, error) {
+		return Node{}, errView
+	})
+}
+
+func fallbackView(err error) Node {
+	return NewTag("p", InnerText("fallback: "+err.Error()))
+}
+
+func TestTry(t *testing.T) {
+	node := NewTag("div", Try(func() (Node, error) {
+		return InnerText("ok"), nil
+	}))
+	bytes, err := node.RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "<div>ok</div>", string(bytes))
+}
+
+func TestTryError(t *testing.T) {
+	node := NewTag("div", InnerText("before"), failingView(), InnerText("after"))
+
+	bytes, err := node.RenderContext(context.Background())
+	require.ErrorIs(t, err, errView)
+	require.Nil(t, bytes)
+
+	require.ErrorIs(t, node.RenderTo(&chunkWriter{}), errView)
+
+	// Render has no way to report the error, so the failed node is skipped.
+	require.Equal(t, "<div>beforeafter</div>", node.String())
+}
+
+func TestErrorBoundary(t *testing.T) {
+	node := NewTag("div",
+		InnerText("before"),
+		ErrorBoundary(fallbackView, NewTag("section", InnerText("partial"), failingView())),
+		InnerText("after"),
+	)
+	expected := "<div>before<p>fallback: view failed</p>after</div>"
+
+	bytes, err := node.RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bytes))
+	require.Equal(t, expected, node.String())
+}
+
+func TestErrorBoundaryNoError(t *testing.T) {
+	node := ErrorBoundary(fallbackView, NewTag("section", InnerText("content")))
+	bytes, err := node.RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "<section>content</section>", string(bytes))
+}
+
+func TestErrorBoundaryPanic(t *testing.T) {
+	node := ErrorBoundary(fallbackView, Func(func(ctx context.Context) Node {
+		panic("oops")
+	}))
+	bytes, err := node.RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "<p>fallback: html: panic while rendering: oops</p>", string(bytes))
+
+	cause := errors.New("cause")
+	node = ErrorBoundary(func(err error) Node {
+		require.ErrorIs(t, err, cause)
+		return InnerText("recovered")
+	}, Func(func(ctx context.Context) Node {
+		panic(cause)
+	}))
+	require.Equal(t, "recovered", node.String())
+}
+
+func TestErrorBoundaryFunc(t *testing.T) {
+	items := []string{"a", "b"}
+	view := func() Node {
+		return NewTag("ul", ForEach(items, func(item string) Node {
+			if item == "b" {
+				panic("bad item")
+			}
+			return NewTag("li", InnerText(item))
+		}))
+	}
+	require.Panics(t, func() { ErrorBoundary(fallbackView, NewTag("div", view())) })
+
+	node := ErrorBoundaryFunc(fallbackView, view)
+	bytes, err := node.RenderContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "<p>fallback: html: panic while rendering: bad item</p>", string(bytes))
+
+	items = []string{"a"}
+	require.Equal(t, "<ul><li>a</li></ul>", node.String())
+}
+
+func TestErrorBoundaryNested(t *testing.T) {
+	node := ErrorBoundary(fallbackView, NewTag("div",
+		ErrorBoundary(func(err error) Node {
+			return InnerText("inner")
+		}, failingView()),
+		InnerText("outer"),
+	))
+	require.Equal(t, "<div>innerouter</div>", node.String())
+}
+
+func TestErrorBoundaryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	node := ErrorBoundary(fallbackView, Func(func(ctx context.Context) Node {
+		cancel()
+		return NewTag("div")
+	}))
+	_, err := node.RenderContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestErrorBoundaryFlush(t *testing.T) {
+	writer := &chunkWriter{}
+	node := Combine(
+		InnerText("one"),
+		Flush(),
+		ErrorBoundary(fallbackView, Combine(InnerText("two"), Flush(), failingView())),
+		Flush(),
+	)
+	require.NoError(t, node.RenderTo(writer))
+	require.Equal(t, []string{"one", "<p>fallback: view failed</p>"}, writer.chunks)
+}
@@ -0,0 +1,193 @@
+package html
+
+import (
+	"context"
+	"fmt"
+)
+
+// Evaluate returns a copy of the node with every lazy node replaced by the
+// node it produces. Func nodes are called with ctx, Try nodes that fail
+// inside of an ErrorBoundary are replaced by the fallback, Cached nodes are
+// built without consulting the cache, and the HeadContent of each Document is
+// moved into its <head>. Inspecting the returned node with
+// Children or Text does not call the lazy nodes again, so Evaluate is useful
+// when a tree is inspected several times before it is rendered.
+//
+// Evaluate returns the first error returned by a Try node outside of an
+// ErrorBoundary, or ctx.Err() if the context is canceled. The returned node
+// omits the failed parts of the tree.
+//
+// Example Usage:
+//
+//	page, err := html.Evaluate(r.Context(), indexDocument(r))
+//	if err != nil {
+//		return err
+//	}
+//	issues := a11y.Check(page)
+func Evaluate(ctx context.Context, node Node) (Node, error) {
+	e := evaluator{ctx: ctx}
+	node.visitAsContent(&e)
+	if e.err == nil {
+		e.err = ctx.Err()
+	}
+	return Node{nodeType: nodeTypeMany, children: e.nodes}, e.err
+}
+
+// visitEvaluated visits an evaluated copy of the Document. It is used for
+// visitors that don't collect HeadContent themselves, so they see the
+// document as it renders.
+func (n *Node) visitEvaluated(visitor TagVisitor) {
+	ctx := context.Background()
+	if withContext, ok := visitor.(contextVisitor); ok {
+		ctx = withContext.Context()
+	}
+	e := evaluator{ctx: ctx}
+	e.Document(n)
+	if errVisitor, ok := visitor.(errorVisitor); ok && e.err != nil {
+		errVisitor.Error(e.err)
+	}
+	for i := range e.nodes {
+		e.nodes[i].visitAsContent(visitor)
+	}
+}
+
+// evaluator is the TagVisitor used by Evaluate. It rebuilds each element with
+// its content evaluated.
+type evaluator struct {
+	ctx   context.Context
+	nodes []Node
+	err   error
+	// head collects the HeadContent nodes inside of a Document. It is nil
+	// outside of a Document.
+	head *[]Node
+}
+
+func (e *evaluator) Tag(name string, node *Node) {
+	var attributes evaluatedAttributes
+	node.VisitAttributes(&attributes)
+	content := evaluator{ctx: e.ctx, nodes: attributes, head: e.head}
+	node.VisitChildren(&content)
+	if e.err == nil {
+		e.err = content.err
+	}
+	if node.callSite() != nil {
+		// Keep the call site so strict mode errors still point at the
+		// code that created the tag.
+		content.nodes = append(content.nodes, node.children[len(node.children)-1])
+	}
+	e.nodes = append(e.nodes, Node{nodeType: node.nodeType, str1: node.str1, children: content.nodes})
+}
+
+func (e *evaluator) VoidTag(name string, node *Node) {
+	e.nodes = append(e.nodes, *node)
+}
+
+func (e *evaluator) Content(content string) {
+	e.nodes = append(e.nodes, Node{nodeType: nodeTypeRawText, str1: content})
+}
+
+// Document evaluates the document and moves the HeadContent inside of it into
+// the <head>.
+func (e *evaluator) Document(node *Node) {
+	var head []Node
+	document := evaluator{ctx: e.ctx, head: &head}
+	node.VisitChildren(&document)
+	if e.err == nil {
+		e.err = document.err
+	}
+	if content := resolveHeadContent(head); len(content) != 0 {
+		for i := range document.nodes {
+			if html := &document.nodes[i]; html.nodeType == nodeTypeTag && html.str1 == "html" {
+				html.children = appendToHead(html.children, content)
+				break
+			}
+		}
+	}
+	e.nodes = append(e.nodes, document.nodes...)
+}
+
+// HeadContent evaluates the content and collects it if the node is inside of
+// a Document.
+func (e *evaluator) HeadContent(node *Node) {
+	if e.head == nil {
+		node.VisitChildren(e)
+		return
+	}
+	content := evaluator{ctx: e.ctx}
+	node.VisitChildren(&content)
+	if e.err == nil {
+		e.err = content.err
+	}
+	*e.head = append(*e.head, Node{nodeType: nodeTypeHeadContent, str1: node.str1, children: content.nodes})
+}
+
+func (e *evaluator) Flush() {
+	e.nodes = append(e.nodes, Node{nodeType: nodeTypeFlush})
+}
+
+func (e *evaluator) Context() context.Context {
+	return e.ctx
+}
+
+func (e *evaluator) Error(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// ErrorBoundary evaluates the child. If the child fails or panics, the
+// fallback is evaluated instead.
+func (e *evaluator) ErrorBoundary(child *Node, fallback func(error) Node) {
+	content := evaluator{ctx: e.ctx, head: e.head}
+	var collected int
+	if e.head != nil {
+		collected = len(*e.head)
+	}
+	err := content.tryVisit(child)
+	if err == nil || err == e.ctx.Err() {
+		e.nodes = append(e.nodes, content.nodes...)
+		if e.err == nil {
+			e.err = err
+		}
+		return
+	}
+	if e.head != nil {
+		// The HeadContent of the failed child is discarded with its output.
+		*e.head = (*e.head)[:collected]
+	}
+	node := fallback(err)
+	node.visitAsContent(e)
+}
+
+// tryVisit evaluates the node and converts panics into errors.
+func (e *evaluator) tryVisit(node *Node) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(error); ok {
+				err = fmt.Errorf("html: panic while rendering: %w", rErr)
+			} else {
+				err = fmt.Errorf("html: panic while rendering: %v", r)
+			}
+		}
+	}()
+	node.visitAsContent(e)
+	return e.err
+}
+
+func (e *evaluator) Invalid(node *Node) {
+	// Keep the invalid node so rendering the result reports it in strict
+	// mode.
+	e.nodes = append(e.nodes, *node)
+}
+
+// evaluatedAttributes copies a tag's attributes. The names and values are
+// already escaped, so they are stored as is.
+type evaluatedAttributes []Node
+
+func (a *evaluatedAttributes) Attribute(name string, value *string) {
+	if value == nil {
+		*a = append(*a, Node{nodeType: nodeTypeBoolAttr, str1: name})
+	} else {
+		*a = append(*a, Node{nodeType: nodeTypeAttr, str1: name, str2: *value})
+	}
+}
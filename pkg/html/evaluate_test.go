@@ -0,0 +1,55 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	calls := 0
+	node := NewTag("div",
+		NewAttribute("id", "greeting"),
+		NewBoolAttribute("hidden"),
+		Func(func(ctx context.Context) Node {
+			calls++
+			return InnerText("hello " + ctx.Value(userKey{}).(string))
+		}),
+		ErrorBoundary(
+			func(err error) Node { return NewTag("p", InnerText(err.Error())) },
+			Try(func() (Node, error) { return Node{}, errors.New("offline") }),
+		),
+		newVoidTag("br"),
+	)
+
+	ctx := context.WithValue(context.Background(), userKey{}, "jeff")
+	evaluated, err := Evaluate(ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	expected := `<div id="greeting" hidden>hello jeff<p>offline</p><br></div>`
+	require.Equal(t, expected, evaluated.String())
+	require.Equal(t, "hello jeffoffline", evaluated.Text())
+	require.Len(t, evaluated.Children()[0].Children(), 3)
+	require.Equal(t, 1, calls)
+}
+
+func TestEvaluateError(t *testing.T) {
+	node := NewTag("div",
+		InnerText("before"),
+		Try(func() (Node, error) { return Node{}, errors.New("offline") }),
+	)
+	evaluated, err := Evaluate(context.Background(), node)
+	require.EqualError(t, err, "offline")
+	require.Equal(t, "<div>before</div>", evaluated.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Evaluate(ctx, NewTag("div", Func(func(ctx context.Context) Node {
+		t.Fatal("Func called after cancel")
+		return Node{}
+	})))
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,19 @@
+package html
+
+// Flush marks a point where a streaming render should send everything
+// rendered so far to the client. When rendered with Node.RenderTo, the
+// buffered HTML is written to the io.Writer and, if the writer is an
+// http.Flusher, the writer is flushed. Flush renders as nothing and is ignored
+// by Node.Render.
+//
+// Example Usage:
+//
+//	document := Document(
+//		tag.Head(tag.Link(attr.Rel("stylesheet"), attr.HRef("/style.css"))),
+//		Flush(),
+//		tag.Body(expensiveView()),
+//	)
+//	err := document.RenderTo(w)
+func Flush() Node {
+	return Node{nodeType: nodeTypeFlush}
+}
@@ -0,0 +1,12 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushRender(t *testing.T) {
+	node := NewTag("div", InnerText("before"), Flush(), InnerText("after"))
+	require.Equal(t, "<div>beforeafter</div>", node.String())
+}
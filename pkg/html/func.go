@@ -0,0 +1,23 @@
+package html
+
+import "context"
+
+// Func creates a lazy node. The function is called each time the node is
+// rendered and receives the context passed to Node.RenderContext. Func makes
+// request scoped values like the current user, the locale, or a CSRF token
+// available to deeply nested components without threading them through every
+// view function. Lazy nodes are rendered as content, so attributes returned
+// by the function are ignored.
+//
+// Example Usage:
+//
+//	csrfInput := Func(func(ctx context.Context) Node {
+//		return tag.Input(attr.Type("hidden"), attr.Value(csrfToken(ctx)))
+//	})
+//	bytes, err := tag.Form(csrfInput).RenderContext(request.Context())
+func Func(view func(ctx context.Context) Node) Node {
+	return Node{
+		nodeType: nodeTypeFunc,
+		children: withPayload(Node{}, view),
+	}
+}
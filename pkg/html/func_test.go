@@ -0,0 +1,60 @@
+package html
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type userKey struct{}
+
+func TestFunc(t *testing.T) {
+	greeting := Func(func(ctx context.Context) Node {
+		user, ok := ctx.Value(userKey{}).(string)
+		if !ok {
+			return InnerText("hello stranger")
+		}
+		return InnerText("hello " + user)
+	})
+	node := NewTag("div", NewAttribute("id", "greeting"), greeting)
+
+	ctx := context.WithValue(context.Background(), userKey{}, "<jeff>")
+	bytes, err := node.RenderContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, `<div id="greeting">hello &lt;jeff&gt;</div>`, string(bytes))
+
+	require.Equal(t, `<div id="greeting">hello stranger</div>`, node.String())
+}
+
+func TestFuncIgnoresAttributes(t *testing.T) {
+	node := NewTag("div", Func(func(ctx context.Context) Node {
+		return Combine(NewAttribute("id", "ignored"), InnerText("content"))
+	}))
+	require.Equal(t, "<div>content</div>", node.String())
+}
+
+func TestRenderContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	node := NewTag("ul",
+		NewTag("li", Func(func(ctx context.Context) Node {
+			calls++
+			cancel()
+			return InnerText("one")
+		})),
+		NewTag("li", Func(func(ctx context.Context) Node {
+			calls++
+			return InnerText("two")
+		})),
+	)
+
+	bytes, err := node.RenderContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, bytes)
+	require.Equal(t, 1, calls)
+
+	_, err = node.RenderContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,177 @@
+package html
+
+import "errors"
+
+// HeadContent contributes content, like a <title>, a <meta> description, or a
+// stylesheet, to the <head> of the Document it is placed in. HeadContent may
+// be placed anywhere in the document, including in the nodes returned by lazy
+// nodes like Func, Try, ForEachParallel, and Cached, so a component deep in
+// the tree can set the title of the page without the layout knowing about it.
+//
+// Document collects HeadContent while it renders. The rest of the document is
+// buffered and the collected content is emitted at the end of the <head> once
+// the document is complete. If several HeadContent nodes have the same key,
+// the last one wins, so a page can replace a default placed by the layout.
+// Content with an empty key is never replaced. HeadContent placed in a
+// fragment without a Document is rendered in place.
+//
+// Flushing the output inside of a Document, with a Flush node or
+// FlushAfterHead, writes the <head> with the content collected so far.
+// HeadContent rendered after that can't be added to the head, so
+// Node.RenderTo fails with ErrHeadFlushed. A Cached fragment that contains
+// HeadContent is rendered, but never stored, because the cache only stores
+// the fragment's bytes.
+//
+// Example Usage:
+//
+//	func articleView(article Article) html.Node {
+//		return tag.Article(
+//			html.HeadContent("title", tag.Title(html.InnerText(article.Title))),
+//			html.HeadContent("canonical", tag.Link(attr.Rel("canonical"), attr.HRef(article.URL))),
+//			tag.H1(html.InnerText(article.Title)),
+//			...
+//		)
+//	}
+//
+//	html.Document(
+//		tag.Head(
+//			tag.Meta(attr.CharSet("utf-8")),
+//			html.HeadContent("title", tag.Title(html.InnerText("Blog"))),
+//		),
+//		tag.Body(articleView(article)),
+//	)
+//
+// renders as
+// <!DOCTYPE html><html><head><meta charset="utf-8"><title>...</title><link rel="canonical" href="..."></head>
+// <body><article><h1>...</h1>...</article></body></html>
+func HeadContent(key string, content ...Node) Node {
+	return Node{
+		nodeType: nodeTypeHeadContent,
+		str1:     key,
+		children: content,
+	}
+}
+
+// ErrHeadFlushed is returned by Node.RenderTo when HeadContent is rendered
+// after the <head> of its Document was written to the output by a Flush node
+// or FlushAfterHead. Place the HeadContent before the first flush or render
+// the document without flushing it. ErrHeadFlushed is not recovered by an
+// ErrorBoundary.
+var ErrHeadFlushed = errors.New("html: the <head> was flushed before all of its content was rendered")
+
+// documentState tracks the Document being rendered. The HeadContent nodes are
+// recorded in the order they are rendered and inserted into the rendered
+// bytes once the head can no longer change.
+type documentState struct {
+	head []Node
+	// htmlStart is the offset of the content of <html> in the rendered
+	// bytes and headEnd is the offset of </head>. They are -1 until the tags
+	// are rendered.
+	htmlStart int
+	headEnd   int
+	// headOpen is set once <head> is rendered and headWritten once the
+	// collected content has been inserted into the output.
+	headOpen    bool
+	headWritten bool
+}
+
+func newDocumentState() *documentState {
+	return &documentState{htmlStart: -1, headEnd: -1}
+}
+
+// enter records the position of the content of <html> and that the <head> is
+// open. offset is the length of the rendered bytes.
+func (d *documentState) enter(name string, offset int) {
+	switch {
+	case name == "html" && d.htmlStart < 0:
+		d.htmlStart = offset
+	case name == "head":
+		d.headOpen = true
+	}
+}
+
+// leave records the position of </head>.
+func (d *documentState) leave(name string, offset int) {
+	if name == "head" && d.headEnd < 0 {
+		d.headEnd = offset
+	}
+}
+
+// resolveHeadContent returns the content of the HeadContent nodes. A node
+// replaces the content of an earlier node with the same key, keeping the
+// earlier node's position.
+func resolveHeadContent(nodes []Node) []Node {
+	var content []Node
+	var keys map[string]int
+	for i := range nodes {
+		node := Combine(nodes[i].children...)
+		key := nodes[i].str1
+		if key == "" {
+			content = append(content, node)
+			continue
+		}
+		if j, ok := keys[key]; ok {
+			content[j] = node
+			continue
+		}
+		if keys == nil {
+			keys = map[string]int{}
+		}
+		keys[key] = len(content)
+		content = append(content, node)
+	}
+	return content
+}
+
+// appendToHead returns a copy of the children of <html> with the content
+// appended to the <head>. If there is no <head>, one is added before the
+// first child that renders content. It is used to build the evaluated copy
+// of a Document.
+func appendToHead(children []Node, content []Node) []Node {
+	if result, ok := appendToHeadTag(children, content); ok {
+		return result
+	}
+	i := 0
+	for i < len(children) && onlyAttributes(children[i:i+1]) {
+		i++
+	}
+	result := make([]Node, 0, len(children)+1)
+	result = append(result, children[:i]...)
+	result = append(result, NewTag("head", content...))
+	return append(result, children[i:]...)
+}
+
+func appendToHeadTag(children []Node, content []Node) ([]Node, bool) {
+	for i := range children {
+		child := children[i]
+		switch {
+		case child.nodeType == nodeTypeTag && child.str1 == "head":
+			child.children = appendContent(child.children, content)
+		case child.nodeType == nodeTypeMany:
+			grandchildren, ok := appendToHeadTag(child.children, content)
+			if !ok {
+				continue
+			}
+			child.children = grandchildren
+		default:
+			continue
+		}
+		result := append([]Node(nil), children...)
+		result[i] = child
+		return result, true
+	}
+	return nil, false
+}
+
+// appendContent returns a copy of the children with the content appended,
+// keeping the call site recorded in strict mode last.
+func appendContent(children []Node, content []Node) []Node {
+	end := len(children)
+	if end != 0 && children[end-1].nodeType == nodeTypeCallSite {
+		end--
+	}
+	result := make([]Node, 0, len(children)+len(content))
+	result = append(result, children[:end]...)
+	result = append(result, content...)
+	return append(result, children[end:]...)
+}
@@ -0,0 +1,222 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadContent(t *testing.T) {
+	title := func(text string) Node {
+		return HeadContent("title", NewTag("title", InnerText(text)))
+	}
+	article := NewTag("article",
+		title("Article"),
+		HeadContent("canonical", NewVoidTag("link", NewAttribute("rel", "canonical"), NewAttribute("href", "/a"))),
+		NewTag("h1", InnerText("Article")),
+		HeadContent("", NewVoidTag("link", NewAttribute("rel", "stylesheet"), NewAttribute("href", "/a.css"))),
+		HeadContent("", NewVoidTag("link", NewAttribute("rel", "stylesheet"), NewAttribute("href", "/b.css"))),
+	)
+	layout := func(content Node) Node {
+		return Document(
+			NewAttribute("lang", "en"),
+			NewTag("head",
+				NewVoidTag("meta", NewAttribute("charset", "utf-8")),
+				title("Blog"),
+			),
+			NewTag("body", NewTag("main", content)),
+		)
+	}
+
+	page := layout(article)
+	require.Equal(t, ``+
+		`<!DOCTYPE html><html lang="en"><head>`+
+		`<meta charset="utf-8">`+
+		`<title>Article</title>`+
+		`<link rel="canonical" href="/a">`+
+		`<link rel="stylesheet" href="/a.css">`+
+		`<link rel="stylesheet" href="/b.css">`+
+		`</head>`+
+		`<body><main><article><h1>Article</h1></article></main></body></html>`,
+		page.String())
+	require.Empty(t, Validate(page))
+	require.NoError(t, page.RenderTo(&bytes.Buffer{}, Strict()))
+
+	// The layout's title is the default.
+	require.Equal(t,
+		`<!DOCTYPE html><html lang="en"><head><meta charset="utf-8"><title>Blog</title></head><body><main></main></body></html>`,
+		layout(Node{}).String())
+
+	// Outside of a Document, HeadContent is rendered in place.
+	require.Equal(t,
+		`<article><title>Article</title><link rel="canonical" href="/a"><h1>Article</h1>`+
+			`<link rel="stylesheet" href="/a.css"><link rel="stylesheet" href="/b.css"></article>`,
+		article.String())
+}
+
+func TestHeadContentWithoutHead(t *testing.T) {
+	page := Document(
+		NewAttribute("lang", "en"),
+		NewTag("body", Combine(HeadContent("title", NewTag("title", InnerText("Hello"))))),
+	)
+	require.Equal(t,
+		`<!DOCTYPE html><html lang="en"><head><title>Hello</title></head><body></body></html>`,
+		page.String())
+
+	// The head may be wrapped in Combine.
+	page = Document(
+		Combine(NewTag("head"), NewTag("body", HeadContent("title", NewTag("title", InnerText("Hello"))))),
+	)
+	require.Equal(t,
+		`<!DOCTYPE html><html><head><title>Hello</title></head><body></body></html>`,
+		page.String())
+}
+
+func TestHeadContentLazy(t *testing.T) {
+	title := func(text string) Node {
+		return HeadContent("title", NewTag("title", InnerText(text)))
+	}
+	style := func(href string) Node {
+		return HeadContent("", NewVoidTag("link", NewAttribute("rel", "stylesheet"), NewAttribute("href", href)))
+	}
+	cache := NewLRUCache(1 << 20)
+	page := Document(
+		NewTag("head", title("Default")),
+		NewTag("body",
+			Func(func(ctx context.Context) Node {
+				return Combine(title("Lazy"), NewTag("h1", InnerText("Lazy")))
+			}),
+			Try(func() (Node, error) { return style("/try.css"), nil }),
+			ForEachParallel([]string{"/a.css", "/b.css"}, style),
+			Cached("sidebar", time.Minute, func() Node {
+				return NewTag("aside", style("/sidebar.css"))
+			}),
+			ErrorBoundary(func(err error) Node { return NewTag("p", InnerText("offline")) },
+				Combine(style("/failed.css"), Try(func() (Node, error) { return Node{}, errors.New("offline") })),
+			),
+		),
+	)
+	expected := `` +
+		`<!DOCTYPE html><html><head>` +
+		`<title>Lazy</title>` +
+		`<link rel="stylesheet" href="/try.css">` +
+		`<link rel="stylesheet" href="/a.css">` +
+		`<link rel="stylesheet" href="/b.css">` +
+		`<link rel="stylesheet" href="/sidebar.css">` +
+		`</head><body><h1>Lazy</h1><aside></aside><p>offline</p></body></html>`
+	for i := 0; i < 2; i++ {
+		var buffer bytes.Buffer
+		require.NoError(t, page.RenderTo(&buffer, Parallel(2), WithCache(cache), Strict()))
+		require.Equal(t, expected, buffer.String())
+	}
+	// The fragment's HeadContent can't be cached with its bytes.
+	_, ok := cache.Get("sidebar")
+	require.False(t, ok)
+
+	// Visitors that don't collect HeadContent see the evaluated document.
+	require.Empty(t, Validate(page))
+	head, ok := Query(page, "head > title")
+	require.True(t, ok)
+	require.Equal(t, "Lazy", head.Text())
+	evaluated, err := Evaluate(context.Background(), page)
+	require.NoError(t, err)
+	require.Equal(t, expected, evaluated.String())
+}
+
+func TestHeadContentFlush(t *testing.T) {
+	page := Document(
+		NewTag("head", NewTag("title", InnerText("Blog"))),
+		NewTag("body",
+			HeadContent("", NewVoidTag("meta", NewAttribute("name", "description"))),
+			InnerText("intro"),
+			Flush(),
+			InnerText("rest"),
+		),
+	)
+	writer := &chunkWriter{}
+	require.NoError(t, page.RenderTo(writer))
+	require.Equal(t, []string{
+		`<!DOCTYPE html><html><head><title>Blog</title><meta name="description"></head><body>intro`,
+	}, writer.chunks)
+	require.Equal(t, `rest</body></html>`, writer.buffer.String())
+
+	// A Flush inside of the head is deferred until the head is closed.
+	writer = &chunkWriter{}
+	page = Document(
+		NewTag("head", Flush(), NewTag("title", InnerText("Blog"))),
+		NewTag("body", HeadContent("", NewVoidTag("meta", NewAttribute("name", "description"))), Flush()),
+	)
+	require.NoError(t, page.RenderTo(writer))
+	require.Equal(t, []string{
+		`<!DOCTYPE html><html><head><title>Blog</title><meta name="description"></head><body>`,
+	}, writer.chunks)
+}
+
+func TestHeadContentAfterFlush(t *testing.T) {
+	title := HeadContent("title", NewTag("title", InnerText("Late")))
+	tests := []struct {
+		name    string
+		page    Node
+		options []RenderOption
+	}{
+		{
+			"flush",
+			Document(NewTag("head"), NewTag("body", Flush(), title)),
+			nil,
+		},
+		{
+			"flush before the head",
+			Document(Flush(), NewTag("head"), NewTag("body", title)),
+			nil,
+		},
+		{
+			"flush after head",
+			Document(NewTag("head"), NewTag("body", title)),
+			[]RenderOption{FlushAfterHead()},
+		},
+		{
+			"error boundary",
+			Document(NewTag("head"), NewTag("body", Flush(), ErrorBoundary(func(error) Node {
+				return InnerText("fallback")
+			}, title))),
+			nil,
+		},
+	}
+	for _, tc := range tests {
+		err := tc.page.RenderTo(&chunkWriter{}, tc.options...)
+		require.ErrorIs(t, err, ErrHeadFlushed, tc.name)
+		require.EqualError(t, err, `html: the <head> was flushed before all of its content was rendered: HeadContent "title"`, tc.name)
+
+		err = tc.page.RenderTo(&chunkWriter{}, append(tc.options, Strict())...)
+		require.EqualError(t, err, `html: strict: HeadContent "title" is rendered after the <head> was flushed`, tc.name)
+
+		// Without an output to flush, the whole document is buffered.
+		require.Contains(t, tc.page.String(), `<head><title>Late</title></head>`, tc.name)
+	}
+}
+
+func TestHeadContentCachedOutsideDocument(t *testing.T) {
+	cache := NewLRUCache(1 << 20)
+	fragment := Cached("fragment", time.Minute, func() Node {
+		return NewTag("div", HeadContent("title", NewTag("title", InnerText("T"))), InnerText("body"))
+	})
+
+	// Outside of a Document, the HeadContent is rendered in place, so the
+	// fragment can't be stored.
+	var buffer bytes.Buffer
+	require.NoError(t, fragment.RenderTo(&buffer, WithCache(cache)))
+	require.Equal(t, `<div><title>T</title>body</div>`, buffer.String())
+	_, ok := cache.Get("fragment")
+	require.False(t, ok)
+
+	buffer.Reset()
+	page := Document(NewTag("head"), NewTag("body", fragment))
+	require.NoError(t, page.RenderTo(&buffer, WithCache(cache)))
+	require.Equal(t, `<!DOCTYPE html><html><head><title>T</title></head><body><div>body</div></body></html>`, buffer.String())
+	_, ok = cache.Get("fragment")
+	require.False(t, ok)
+}
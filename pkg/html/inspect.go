@@ -0,0 +1,120 @@
+package html
+
+import "html"
+
+// Attribute describes an attribute of an element. It is returned by
+// Node.Attributes.
+type Attribute struct {
+	Name string
+	// Value is the unescaped value of the attribute. Value is empty for
+	// bool attributes.
+	Value string
+	// IsBool is true for attributes created by NewBoolAttribute.
+	IsBool bool
+}
+
+// TagName returns the name of the element. TagName returns "" if the node is
+// not an element.
+func (n Node) TagName() string {
+	switch n.nodeType {
+	case nodeTypeTag, nodeTypeVoidTag, nodeTypeForeignTag:
+		return n.str1
+	default:
+		return ""
+	}
+}
+
+// IsVoid returns true if the node is an element without a closing tag.
+func (n Node) IsVoid() bool {
+	return n.nodeType == nodeTypeVoidTag
+}
+
+// IsText returns true if the node was created by InnerText or RawInnerText.
+func (n Node) IsText() bool {
+	return n.nodeType == nodeTypeRawText
+}
+
+// Attributes returns the node's attributes in the order they are rendered.
+// Names and values are unescaped.
+func (n Node) Attributes() []Attribute {
+	var attributes inspectAttributes
+	n.VisitAttributes(&attributes)
+	return attributes
+}
+
+// Attribute returns the unescaped value of the first attribute with the
+// name. The second result is false if the node has no such attribute.
+func (n Node) Attribute(name string) (string, bool) {
+	for _, attribute := range n.Attributes() {
+		if attribute.Name == name {
+			return attribute.Value, true
+		}
+	}
+	return "", false
+}
+
+// Children returns the elements and text nodes rendered inside of the node.
+// If the node is not an element, e.g. it was created by Combine or ForEach,
+// Children returns the top level elements and text nodes. Lazy nodes are
+// evaluated with context.Background().
+func (n Node) Children() []Node {
+	var children inspectChildren
+	switch n.nodeType {
+	case nodeTypeTag, nodeTypeForeignTag:
+		n.VisitChildren(&children)
+	case nodeTypeVoidTag, nodeTypeRawText:
+		// Children of void tags are not rendered and text has no children.
+	default:
+		n.Visit(&children)
+	}
+	return children
+}
+
+// Text returns the unescaped text rendered by the node. For elements, Text
+// returns the text of all of the element's descendants.
+//
+// Example Usage:
+//
+//	node := tag.P(InnerText("fish "), tag.Em(InnerText("&")), InnerText(" chips"))
+//	node.Text() == "fish & chips"
+func (n Node) Text() string {
+	var text inspectText
+	n.Visit(&text)
+	return html.UnescapeString(string(text))
+}
+
+type inspectAttributes []Attribute
+
+func (a *inspectAttributes) Attribute(name string, value *string) {
+	attribute := Attribute{Name: html.UnescapeString(name), IsBool: value == nil}
+	if value != nil {
+		attribute.Value = html.UnescapeString(*value)
+	}
+	*a = append(*a, attribute)
+}
+
+type inspectChildren []Node
+
+func (c *inspectChildren) Tag(name string, node *Node) {
+	*c = append(*c, *node)
+}
+
+func (c *inspectChildren) VoidTag(name string, node *Node) {
+	*c = append(*c, *node)
+}
+
+func (c *inspectChildren) Content(content string) {
+	*c = append(*c, Node{nodeType: nodeTypeRawText, str1: content})
+}
+
+type inspectText []byte
+
+func (t *inspectText) Tag(name string, node *Node) {
+	node.VisitChildren(t)
+}
+
+func (t *inspectText) VoidTag(name string, node *Node) {}
+
+func (t *inspectText) Content(content string) {
+	*t = append(*t, content...)
+}
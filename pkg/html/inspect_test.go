@@ -0,0 +1,66 @@
+package html
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectElement(t *testing.T) {
+	node := NewTag("p",
+		NewAttribute("class", "a&b"),
+		NewBoolAttribute("hidden"),
+		InnerText("fish "),
+		NewTag("em", InnerText("&")),
+		InnerText(" chips"),
+	)
+	require.Equal(t, "p", node.TagName())
+	require.False(t, node.IsVoid())
+	require.False(t, node.IsText())
+	require.Equal(t, []Attribute{
+		{Name: "class", Value: "a&b"},
+		{Name: "hidden", IsBool: true},
+	}, node.Attributes())
+
+	value, ok := node.Attribute("class")
+	require.True(t, ok)
+	require.Equal(t, "a&b", value)
+	_, ok = node.Attribute("id")
+	require.False(t, ok)
+
+	require.Equal(t, "fish & chips", node.Text())
+
+	children := node.Children()
+	require.Len(t, children, 3)
+	require.True(t, children[0].IsText())
+	require.Equal(t, "fish ", children[0].Text())
+	require.Equal(t, "em", children[1].TagName())
+	require.Equal(t, " chips", children[2].Text())
+	require.Empty(t, children[2].Children())
+}
+
+func TestInspectVoidTag(t *testing.T) {
+	node := NewVoidTag("img", NewAttribute("alt", "cat"), NewTag("ignored"))
+	require.Equal(t, "img", node.TagName())
+	require.True(t, node.IsVoid())
+	require.Empty(t, node.Children())
+	require.Empty(t, node.Text())
+}
+
+func TestInspectFragment(t *testing.T) {
+	node := Combine(
+		NewAttribute("id", "hoisted"),
+		Func(func(ctx context.Context) Node {
+			return NewTag("span")
+		}),
+		InnerText("text"),
+	)
+	require.Equal(t, "", node.TagName())
+	require.Equal(t, []Attribute{{Name: "id", Value: "hoisted"}}, node.Attributes())
+
+	children := node.Children()
+	require.Len(t, children, 2)
+	require.Equal(t, "span", children[0].TagName())
+	require.Equal(t, "text", children[1].Text())
+}
@@ -0,0 +1,24 @@
+package html
+
+import "strings"
+
+// Invalid returns a node that renders as nothing. It is returned by
+// constructors like NewTag and NewAttribute when they are given invalid
+// input, and packages that build on html can use it to reject input the same
+// way. Rendering an invalid node in strict mode reports err. See SetStrict.
+//
+// Example Usage:
+//
+//	func Price(cents int) html.Node {
+//		if cents < 0 {
+//			return html.Invalid(fmt.Errorf("price: negative price %d", cents))
+//		}
+//		...
+//	}
+func Invalid(err error) Node {
+	return Node{
+		nodeType: nodeTypeInvalid,
+		str1:     strings.TrimPrefix(err.Error(), "html: "),
+		children: withCallSite(nil),
+	}
+}
@@ -0,0 +1,19 @@
+package html
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalid(t *testing.T) {
+	invalid := Invalid(errors.New("price: negative price -1"))
+	require.Equal(t, "<p></p>", NewTag("p", invalid).String())
+
+	err := renderStrict(NewTag("p", invalid))
+	require.EqualError(t, err, "html: strict: price: negative price -1")
+
+	err = renderStrict(invalid)
+	require.EqualError(t, err, "html: strict: price: negative price -1")
+}
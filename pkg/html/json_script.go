@@ -0,0 +1,54 @@
+package html
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSONScript renders v as JSON inside of a <script type="application/json">
+// element, which is the safest way to pass data from the server to client
+// code. The script reads the data with
+// JSON.parse(document.getElementById(id).textContent).
+//
+// The JSON is escaped for HTML: <, >, and & are written as \u003c, \u003e,
+// and \u0026, so strings like "</script>" or "<!--" can't end the script
+// early. If v can't be marshaled, JSONScript returns a node that renders as
+// nothing and is reported in strict mode.
+//
+// Example Usage:
+//
+//	html.JSONScript("initial-state", map[string]any{"user": "</script>"})
+//
+// renders as
+// <script type="application/json" id="initial-state">{"user":"\u003c/script\u003e"}</script>
+func JSONScript(id string, v any) Node {
+	return jsonScript("application/json", NewAttribute("id", id), v)
+}
+
+// JSONLD renders v as JSON-LD structured data, like a schema.org Article,
+// inside of a <script type="application/ld+json"> element. The JSON is
+// escaped the same way as JSONScript.
+//
+// Example Usage:
+//
+//	html.JSONLD(map[string]any{
+//		"@context": "https://schema.org",
+//		"@type":    "Article",
+//		"headline": article.Title,
+//	})
+func JSONLD(v any) Node {
+	return jsonScript("application/ld+json", Node{}, v)
+}
+
+func jsonScript(scriptType string, id Node, v any) Node {
+	// json.Marshal escapes <, >, and & as well as U+2028 and U+2029.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Invalid(fmt.Errorf("html: encoding %s script: %w", scriptType, err))
+	}
+	return NewTag("script",
+		NewAttribute("type", scriptType),
+		id,
+		RawInnerText(string(data)),
+	)
+}
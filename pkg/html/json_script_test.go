@@ -0,0 +1,60 @@
+package html
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONScript(t *testing.T) {
+	node := JSONScript("state", map[string]any{"user": "ada", "admin": true})
+	require.Equal(t,
+		`<script type="application/json" id="state">{"admin":true,"user":"ada"}</script>`,
+		node.String())
+
+	node = JSONLD(map[string]any{"@type": "Article", "headline": "Hello"})
+	require.Equal(t,
+		`<script type="application/ld+json">{"@type":"Article","headline":"Hello"}</script>`,
+		node.String())
+}
+
+func TestJSONScriptEscaping(t *testing.T) {
+	payloads := []string{
+		"</script><script>alert(1)</script>",
+		"</SCRIPT >",
+		"<!-- <script>",
+		"]]>",
+		"a & b",
+		"line\u2028separator\u2029",
+	}
+	for _, payload := range payloads {
+		for _, node := range []Node{JSONScript("state", payload), JSONLD(payload)} {
+			rendered := node.String()
+			start := strings.Index(rendered, ">") + 1
+			end := strings.LastIndex(rendered, "</script>")
+			content := rendered[start:end]
+
+			// The script is only closed by the end tag written by JSONScript.
+			require.NotContains(t, strings.ToLower(content), "</script", payload)
+			require.NotContains(t, content, "<!--", payload)
+			require.NotContains(t, content, "\u2028", payload)
+
+			var decoded string
+			require.NoError(t, json.Unmarshal([]byte(content), &decoded))
+			require.Equal(t, payload, decoded)
+		}
+	}
+}
+
+func TestJSONScriptInvalid(t *testing.T) {
+	node := JSONScript("state", math.NaN())
+	require.Equal(t, "", node.String())
+	err := NewTag("body", node).RenderTo(&bytes.Buffer{}, Strict())
+	require.EqualError(t, err, "html: strict: encoding application/json script: json: unsupported value: NaN")
+
+	require.NoError(t, NewTag("body", JSONScript("state", []int{1}), JSONLD(nil)).RenderTo(&bytes.Buffer{}, Strict()))
+}
@@ -0,0 +1,128 @@
+package html
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LRUCache is an in-memory Cache that evicts the least recently used entries
+// once the total size of the stored keys and values exceeds its limit.
+type LRUCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	bytes    int
+	// order contains *lruEntry values. The front of the list is the most
+	// recently used entry.
+	order   *list.List
+	entries map[string]*list.Element
+	stats   CacheStats
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// CacheStats contains counters that describe how effective a cache is.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Entries and Bytes describe the current contents of the cache.
+	Entries int
+	Bytes   int
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates a cache that holds at most maxBytes of keys and
+// rendered fragments.
+func NewLRUCache(maxBytes int) *LRUCache {
+	return &LRUCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+		now:      time.Now,
+	}
+}
+
+// Get implements Cache. Expired entries are treated as misses.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.remove(element)
+		c.stats.Misses++
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	c.stats.Hits++
+	return entry.value, true
+}
+
+// Set implements Cache. Values larger than the cache are not stored.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	size := len(key) + len(value)
+	if size > c.maxBytes {
+		return
+	}
+
+	entry := &lruEntry{key: key, value: value}
+	if ttl != 0 {
+		entry.expires = c.now().Add(ttl)
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	c.bytes += size
+
+	for c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+		c.stats.Evictions++
+	}
+}
+
+// InvalidatePrefix implements Cache.
+func (c *LRUCache) InvalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(element)
+		}
+	}
+}
+
+// Stats returns the cache's hit, miss, and eviction counters along with its
+// current size.
+func (c *LRUCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	stats.Bytes = c.bytes
+	return stats
+}
+
+func (c *LRUCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*lruEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= len(entry.key) + len(entry.value)
+}
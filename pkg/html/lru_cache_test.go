@@ -0,0 +1,88 @@
+package html
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCacheGetSet(t *testing.T) {
+	cache := NewLRUCache(1024)
+	_, ok := cache.Get("a")
+	require.False(t, ok)
+
+	cache.Set("a", []byte("value a"), 0)
+	value, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "value a", string(value))
+
+	cache.Set("a", []byte("replaced"), 0)
+	value, _ = cache.Get("a")
+	require.Equal(t, "replaced", string(value))
+
+	require.Equal(t, CacheStats{Hits: 2, Misses: 1, Entries: 1, Bytes: 9}, cache.Stats())
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	// Each entry is 1 byte of key and 4 bytes of value.
+	cache := NewLRUCache(10)
+	cache.Set("a", []byte("aaaa"), 0)
+	cache.Set("b", []byte("bbbb"), 0)
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", []byte("cccc"), 0)
+	_, ok = cache.Get("b")
+	require.False(t, ok, "b is the least recently used entry")
+	_, ok = cache.Get("a")
+	require.True(t, ok)
+	_, ok = cache.Get("c")
+	require.True(t, ok)
+
+	cache.Set("large", []byte("larger than the cache"), 0)
+	_, ok = cache.Get("large")
+	require.False(t, ok)
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(1), stats.Evictions)
+	require.Equal(t, 2, stats.Entries)
+	require.Equal(t, 10, stats.Bytes)
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := NewLRUCache(1024)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("expires", []byte("value"), time.Minute)
+	cache.Set("forever", []byte("value"), 0)
+
+	now = now.Add(59 * time.Second)
+	_, ok := cache.Get("expires")
+	require.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = cache.Get("expires")
+	require.False(t, ok)
+	_, ok = cache.Get("forever")
+	require.True(t, ok)
+	require.Equal(t, 1, cache.Stats().Entries)
+}
+
+func TestLRUCacheInvalidatePrefix(t *testing.T) {
+	cache := NewLRUCache(1024)
+	cache.Set("article/1", []byte("one"), 0)
+	cache.Set("article/2", []byte("two"), 0)
+	cache.Set("user/1", []byte("user"), 0)
+
+	cache.InvalidatePrefix("article/")
+
+	_, ok := cache.Get("article/1")
+	require.False(t, ok)
+	_, ok = cache.Get("article/2")
+	require.False(t, ok)
+	_, ok = cache.Get("user/1")
+	require.True(t, ok)
+	require.Equal(t, len("user/1user"), cache.Stats().Bytes)
+}
@@ -1,6 +1,9 @@
 package html
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -57,3 +60,71 @@ func TestCombineMixed(t *testing.T) {
 	require.Equal(t, NewTag("span", node).String(),
 		"<span id=\"id-value\" class=\"class-value\"><div>div content</div><button>button content</button></span>")
 }
+
+func renderParallel(node Node, workers int) (string, error) {
+	var buffer bytes.Buffer
+	err := node.RenderTo(&buffer, Parallel(workers))
+	return buffer.String(), err
+}
+
+func TestForEachParallel(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+	view := func(i int) Node {
+		return NewTag("li", InnerText(strconv.Itoa(i)))
+	}
+	expected := NewTag("ul", ForEach(items, view)).String()
+	node := NewTag("ul", ForEachParallel(items, view))
+
+	require.Equal(t, expected, node.String())
+	for _, workers := range []int{0, 1, 2, 8} {
+		result, err := renderParallel(node, workers)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	}
+}
+
+func TestForEachParallelNested(t *testing.T) {
+	rows := []int{1, 2, 3, 4}
+	view := func(row int) Node {
+		return NewTag("tr", ForEachParallel(rows, func(col int) Node {
+			return NewTag("td", InnerText(strconv.Itoa(row*col)))
+		}))
+	}
+	node := NewTag("table", ForEachParallel(rows, view))
+	result, err := renderParallel(node, 2)
+	require.NoError(t, err)
+	require.Equal(t, node.String(), result)
+	require.Contains(t, result, "<tr><td>4</td><td>8</td><td>12</td><td>16</td></tr>")
+}
+
+func TestForEachParallelError(t *testing.T) {
+	items := []int{0, 1, 2, 3}
+	node := ForEachParallel(items, func(i int) Node {
+		return Try(func() (Node, error) {
+			if i >= 2 {
+				return Node{}, fmt.Errorf("item %d failed", i)
+			}
+			return InnerText(strconv.Itoa(i)), nil
+		})
+	})
+	_, err := renderParallel(node, 4)
+	require.EqualError(t, err, "item 2 failed")
+}
+
+func TestForEachParallelPanic(t *testing.T) {
+	items := []int{0, 1, 2, 3}
+	node := ErrorBoundary(func(err error) Node {
+		return InnerText(err.Error())
+	}, ForEachParallel(items, func(i int) Node {
+		if i == 3 {
+			panic("item 3 panicked")
+		}
+		return InnerText(strconv.Itoa(i))
+	}))
+	result, err := renderParallel(node, 4)
+	require.NoError(t, err)
+	require.Equal(t, "html: panic while rendering: item 3 panicked", result)
+}
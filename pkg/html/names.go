@@ -0,0 +1,140 @@
+package html
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// reservedCustomElementNames contain a hyphen but are not valid custom
+// element names because SVG and MathML already use them.
+var reservedCustomElementNames = setOf(
+	"annotation-xml", "color-profile", "font-face", "font-face-src",
+	"font-face-uri", "font-face-format", "font-face-name", "missing-glyph",
+)
+
+// ValidateTagName returns an error if the name is not a valid tag name. Tag
+// names contain ASCII letters and digits and start with a letter. Names that
+// contain a hyphen are custom element names, which must start with a lower
+// case ASCII letter and must not contain upper case ASCII letters.
+func ValidateTagName(name string) error {
+	if isSimpleName(name) {
+		return nil
+	}
+	if name == "" {
+		return errors.New("html: tag name is empty")
+	}
+	if strings.Contains(name, "-") {
+		return validateCustomElementName(name)
+	}
+	if !isASCIILetter(name[0]) {
+		return fmt.Errorf("html: invalid tag name %q: tag names must start with an ASCII letter", name)
+	}
+	for i := 1; i < len(name); i++ {
+		if !isASCIILetter(name[i]) && !isASCIIDigit(name[i]) {
+			return fmt.Errorf("html: invalid tag name %q: tag names may only contain ASCII letters and digits", name)
+		}
+	}
+	return nil
+}
+
+// validateForeignTagName returns an error if the name is not a valid name for
+// an element in foreign content. Foreign elements are not custom elements, so
+// names like annotation-xml and font-face are allowed. Names start with an
+// ASCII letter and contain ASCII letters, digits, and hyphens.
+func validateForeignTagName(name string) error {
+	if isSimpleName(name) {
+		return nil
+	}
+	if name == "" {
+		return errors.New("html: tag name is empty")
+	}
+	if !isASCIILetter(name[0]) {
+		return fmt.Errorf("html: invalid tag name %q: tag names must start with an ASCII letter", name)
+	}
+	for i := 1; i < len(name); i++ {
+		if !isASCIILetter(name[i]) && !isASCIIDigit(name[i]) && name[i] != '-' {
+			return fmt.Errorf("html: invalid tag name %q: foreign tag names may only contain ASCII letters, digits, and hyphens", name)
+		}
+	}
+	return nil
+}
+
+func validateCustomElementName(name string) error {
+	if !('a' <= name[0] && name[0] <= 'z') {
+		return fmt.Errorf("html: invalid custom element name %q: custom element names must start with a lower case ASCII letter", name)
+	}
+	if reservedCustomElementNames[name] {
+		return fmt.Errorf("html: invalid custom element name %q: the name is reserved", name)
+	}
+	for _, r := range name {
+		valid := 'a' <= r && r <= 'z' || '0' <= r && r <= '9' ||
+			r == '-' || r == '.' || r == '_' || r == 0xB7 ||
+			0xC0 <= r && r <= 0xD6 || 0xD8 <= r && r <= 0xF6 ||
+			0xF8 <= r && r <= 0x37D || 0x37F <= r && r <= 0x1FFF ||
+			0x200C <= r && r <= 0x200D || 0x203F <= r && r <= 0x2040 ||
+			0x2070 <= r && r <= 0x218F || 0x2C00 <= r && r <= 0x2FEF ||
+			0x3001 <= r && r <= 0xD7FF || 0xF900 <= r && r <= 0xFDCF ||
+			0xFDF0 <= r && r <= 0xFFFD || 0x10000 <= r && r <= 0xEFFFF
+		if !valid {
+			return fmt.Errorf("html: invalid custom element name %q: %q is not allowed in custom element names", name, r)
+		}
+	}
+	return nil
+}
+
+// ValidateAttributeName returns an error if the name is not a valid
+// attribute name. The HTML syntax allows any character in an attribute name
+// other than whitespace, control characters, noncharacters, and the
+// characters "'>/=. ValidateAttributeName also rejects < and &, since they
+// can't appear in markup without being escaped.
+func ValidateAttributeName(name string) error {
+	if isSimpleName(name) {
+		return nil
+	}
+	if name == "" {
+		return errors.New("html: attribute name is empty")
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("html: invalid attribute name %q: the name is not valid UTF-8", name)
+	}
+	for _, r := range name {
+		if r <= 0x20 || 0x7F <= r && r <= 0x9F || strings.ContainsRune("\"'<>/=&", r) || isNonCharacter(r) {
+			return fmt.Errorf("html: invalid attribute name %q: %q is not allowed in attribute names", name, r)
+		}
+	}
+	return nil
+}
+
+// isSimpleName is the fast path used for the constants in pkg/tag and
+// pkg/attr. Names made of lower case ASCII letters, digits, and hyphens that
+// start with a letter are valid tag and attribute names. Reserved custom
+// element names are handled by the slow path.
+func isSimpleName(name string) bool {
+	if name == "" || !('a' <= name[0] && name[0] <= 'z') {
+		return false
+	}
+	hyphen := false
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if c == '-' {
+			hyphen = true
+		} else if !('a' <= c && c <= 'z') && !isASCIIDigit(c) {
+			return false
+		}
+	}
+	return !hyphen || !reservedCustomElementNames[name]
+}
+
+func isNonCharacter(r rune) bool {
+	return 0xFDD0 <= r && r <= 0xFDEF || r&0xFFFE == 0xFFFE
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
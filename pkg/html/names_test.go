@@ -0,0 +1,72 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTagName(t *testing.T) {
+	valid := []string{
+		"div", "h1", "DIV", "svg", "foreignObject",
+		"my-element", "x-", "math-α", "emotion-😍", "a.b-c_d",
+	}
+	for _, name := range valid {
+		require.NoError(t, ValidateTagName(name), name)
+	}
+
+	invalid := map[string]string{
+		"":                     `html: tag name is empty`,
+		"1div":                 `html: invalid tag name "1div": tag names must start with an ASCII letter`,
+		"div onclick=alert(1)": `html: invalid tag name "div onclick=alert(1)": tag names may only contain ASCII letters and digits`,
+		"div>":                 `html: invalid tag name "div>": tag names may only contain ASCII letters and digits`,
+		"My-Element":           `html: invalid custom element name "My-Element": custom element names must start with a lower case ASCII letter`,
+		"my-Element":           `html: invalid custom element name "my-Element": 'E' is not allowed in custom element names`,
+		"my-el ement":          `html: invalid custom element name "my-el ement": ' ' is not allowed in custom element names`,
+		"font-face":            `html: invalid custom element name "font-face": the name is reserved`,
+	}
+	for name, message := range invalid {
+		require.EqualError(t, ValidateTagName(name), message, name)
+	}
+}
+
+func TestValidateAttributeName(t *testing.T) {
+	valid := []string{
+		"id", "class", "data-user-id", "aria-label", "xlink:href",
+		"@click", ":class", "x-on:click.prevent", "_hyperscript", "données",
+	}
+	for _, name := range valid {
+		require.NoError(t, ValidateAttributeName(name), name)
+	}
+
+	invalid := map[string]string{
+		"":            `html: attribute name is empty`,
+		"escape<test": `html: invalid attribute name "escape<test": '<' is not allowed in attribute names`,
+		"a b":         `html: invalid attribute name "a b": ' ' is not allowed in attribute names`,
+		"x=y":         `html: invalid attribute name "x=y": '=' is not allowed in attribute names`,
+		`a"`:          `html: invalid attribute name "a\"": '"' is not allowed in attribute names`,
+		"a/":          `html: invalid attribute name "a/": '/' is not allowed in attribute names`,
+		"a&b":         `html: invalid attribute name "a&b": '&' is not allowed in attribute names`,
+		"a\x00":       `html: invalid attribute name "a\x00": '\x00' is not allowed in attribute names`,
+		"a\uFFFE":     `html: invalid attribute name "a\ufffe": '\ufffe' is not allowed in attribute names`,
+		"a\xff":       `html: invalid attribute name "a\xff": the name is not valid UTF-8`,
+	}
+	for name, message := range invalid {
+		require.EqualError(t, ValidateAttributeName(name), message, name)
+	}
+}
+
+func TestInvalidNamesRenderNothing(t *testing.T) {
+	require.Equal(t, "", NewTag("div onclick=alert(1)", InnerText("text")).String())
+	require.Equal(t, "", NewVoidTag("img src=x onerror=alert(1)").String())
+	require.Equal(t,
+		`<div id="a"></div>`,
+		NewTag("div", NewAttribute("onclick=alert(1) x", ""), NewAttribute("id", "a")).String())
+	require.Equal(t, `<p></p>`, NewTag("p", NewBoolAttribute("a b")).String())
+}
+
+func BenchmarkValidateTagName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ValidateTagName("blockquote")
+	}
+}
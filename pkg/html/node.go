@@ -1,8 +1,11 @@
 package html
 
+import "context"
+
 // Node represents an HTML tag or attribute. It is the core type of
 // the sanity library. Nodes are immutable and may be safely shared
-// across threads.
+// across threads. The zero value is an empty node that renders as
+// nothing.
 //
 //   - The `tags` package contains constructors for all standard HTML5
 //     tags.
@@ -28,6 +31,10 @@ type Node struct {
 	nodeType nodeType
 	str1     string
 	str2     string
+	//
+	// Node types that need data that can't be represented by strings and
+	// children, like the function wrapped by Func, store it in a payload
+	// behind their only child. See withPayload.
 	children []Node
 }
 
@@ -41,10 +48,24 @@ const (
 
 	nodeTypeTag
 	nodeTypeVoidTag
+	nodeTypeForeignTag
 
 	nodeTypeRawText
 
 	nodeTypeMany
+	nodeTypeDocument
+	nodeTypeHeadContent
+
+	nodeTypeFlush
+
+	nodeTypeFunc
+	nodeTypeTry
+	nodeTypeErrorBoundary
+	nodeTypeParallel
+	nodeTypeCached
+
+	nodeTypeInvalid
+	nodeTypeCallSite
 )
 
 func (n Node) String() string {
@@ -55,10 +76,29 @@ func (n Node) String() string {
 // Writing the byte array to an io.Writer is slightly more efficient
 // than writing a string.
 //
+// Render ignores errors: a Try node that fails outside of an ErrorBoundary is
+// rendered as nothing. Use RenderContext or RenderTo to handle errors.
+//
 // Example Usage:
 // writer.Write(node.Render())
 func (n Node) Render() []byte {
-	renderer := &renderVisitor{}
+	renderer := &renderVisitor{ignoreErrors: true, strict: strict.Load()}
 	n.Visit(renderer)
 	return renderer.bytes
 }
+
+// RenderContext is like Render, but the context is passed to the lazy nodes
+// created by Func. If the context is canceled or a Try node fails outside of
+// an ErrorBoundary, rendering stops and the first error is returned.
+//
+// Example Usage:
+//
+//	bytes, err := node.RenderContext(request.Context())
+func (n Node) RenderContext(ctx context.Context) ([]byte, error) {
+	renderer := newRenderVisitor(ctx)
+	n.Visit(renderer)
+	if renderer.err != nil {
+		return nil, renderer.err
+	}
+	return renderer.bytes, nil
+}
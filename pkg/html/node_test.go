@@ -1,7 +1,9 @@
 package html
 
 import (
+	"errors"
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/require"
 )
@@ -11,3 +13,18 @@ func TestNodeDefault(t *testing.T) {
 	require.Empty(t, n.Render())
 	require.Empty(t, n.String())
 }
+
+func TestNodeSize(t *testing.T) {
+	// Every tag allocates a slice of Nodes, so the size of Node drives the
+	// cost of building a tree. Payloads are stored behind a child instead.
+	// The nodeType is padded to a word, each string is two words, and the
+	// children slice is three words.
+	word := unsafe.Sizeof(uintptr(0))
+	require.Equal(t, 8*word, unsafe.Sizeof(Node{}))
+
+	fallback := InnerText("fallback")
+	boundary := ErrorBoundary(func(error) Node { return fallback }, Try(func() (Node, error) {
+		return Node{}, errors.New("failed")
+	}))
+	require.Equal(t, "fallback", boundary.String())
+}
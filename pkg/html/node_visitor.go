@@ -1,5 +1,7 @@
 package html
 
+import "context"
+
 // Visit calls the `TagVisitor.Tag`, `TagVisitor.VoidTag`, or
 // `TagVisitor.Content` method depending on the type of thhe Node. The Visit*
 // methods are used by Node.Render to convert the Node tree to HTML.
@@ -39,6 +41,63 @@ type AttributeVisitor interface {
 	Attribute(name string, value *string)
 }
 
+// flushVisitor is implemented by visitors that care about Flush nodes. Most
+// visitors have no use for flush points, so it is not part of TagVisitor.
+type flushVisitor interface {
+	Flush()
+}
+
+// contextVisitor is implemented by visitors that supply a context to the lazy
+// nodes created by Func. Visitors that don't implement it evaluate lazy nodes
+// with context.Background().
+type contextVisitor interface {
+	Context() context.Context
+}
+
+// errorVisitor is implemented by visitors that want to know when a Try node
+// fails. Visitors that don't implement it skip failed Try nodes.
+type errorVisitor interface {
+	Error(err error)
+}
+
+// boundaryVisitor is implemented by visitors that can recover from errors
+// inside of an ErrorBoundary. Visitors that don't implement it visit the
+// boundary's child as if the boundary was not there.
+type boundaryVisitor interface {
+	ErrorBoundary(child *Node, fallback func(error) Node)
+}
+
+// parallelVisitor is implemented by visitors that can visit the items created
+// by ForEachParallel concurrently. Visitors that don't implement it visit the
+// items in order.
+type parallelVisitor interface {
+	Parallel(items lazyItems)
+}
+
+// cacheVisitor is implemented by visitors that can use the rendered bytes
+// stored for a Cached node. Visitors that don't implement it visit the result
+// of the node's build function.
+type cacheVisitor interface {
+	Cached(key string, fragment cachedFragment)
+}
+
+// documentVisitor is implemented by visitors that collect the HeadContent
+// rendered inside of a Document. Visitors that don't implement it visit an
+// evaluated copy of the Document, see Evaluate, with the HeadContent already
+// moved into the <head>. HeadContent outside of a Document is visited in
+// place.
+type documentVisitor interface {
+	Document(node *Node)
+	HeadContent(node *Node)
+}
+
+// invalidVisitor is implemented by visitors that want to know when a node
+// created with an invalid tag or attribute name is visited. Visitors that
+// don't implement it skip invalid nodes.
+type invalidVisitor interface {
+	Invalid(node *Node)
+}
+
 func (n *Node) visitAsAttribute(visitor AttributeVisitor) {
 	switch n.nodeType {
 	case nodeTypeAttr:
@@ -54,7 +113,7 @@ func (n *Node) visitAsAttribute(visitor AttributeVisitor) {
 
 func (n *Node) visitAsContent(visitor TagVisitor) {
 	switch n.nodeType {
-	case nodeTypeTag:
+	case nodeTypeTag, nodeTypeForeignTag:
 		visitor.Tag(n.str1, n)
 	case nodeTypeVoidTag:
 		visitor.VoidTag(n.str1, n)
@@ -64,5 +123,70 @@ func (n *Node) visitAsContent(visitor TagVisitor) {
 		for i := range n.children {
 			n.children[i].visitAsContent(visitor)
 		}
+	case nodeTypeDocument:
+		if document, ok := visitor.(documentVisitor); ok {
+			document.Document(n)
+		} else {
+			n.visitEvaluated(visitor)
+		}
+	case nodeTypeHeadContent:
+		if document, ok := visitor.(documentVisitor); ok {
+			document.HeadContent(n)
+		} else {
+			for i := range n.children {
+				n.children[i].visitAsContent(visitor)
+			}
+		}
+	case nodeTypeFlush:
+		if flusher, ok := visitor.(flushVisitor); ok {
+			flusher.Flush()
+		}
+	case nodeTypeFunc:
+		ctx := context.Background()
+		if withContext, ok := visitor.(contextVisitor); ok {
+			ctx = withContext.Context()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		child := n.payload().(func(context.Context) Node)(ctx)
+		child.visitAsContent(visitor)
+	case nodeTypeTry:
+		child, err := n.payload().(func() (Node, error))()
+		if err != nil {
+			if errVisitor, ok := visitor.(errorVisitor); ok {
+				errVisitor.Error(err)
+			}
+			return
+		}
+		child.visitAsContent(visitor)
+	case nodeTypeErrorBoundary:
+		if boundary, ok := visitor.(boundaryVisitor); ok {
+			boundary.ErrorBoundary(&n.children[0], n.payload().(func(error) Node))
+		} else {
+			n.children[0].visitAsContent(visitor)
+		}
+	case nodeTypeParallel:
+		items := n.payload().(lazyItems)
+		if parallel, ok := visitor.(parallelVisitor); ok {
+			parallel.Parallel(items)
+		} else {
+			for i := 0; i < items.count; i++ {
+				item := items.view(i)
+				item.visitAsContent(visitor)
+			}
+		}
+	case nodeTypeCached:
+		fragment := n.payload().(cachedFragment)
+		if cached, ok := visitor.(cacheVisitor); ok {
+			cached.Cached(n.str1, fragment)
+		} else {
+			child := fragment.build()
+			child.visitAsContent(visitor)
+		}
+	case nodeTypeInvalid:
+		if invalid, ok := visitor.(invalidVisitor); ok {
+			invalid.Invalid(n)
+		}
 	}
 }
@@ -0,0 +1,36 @@
+package html
+
+import "unsafe"
+
+// nodePayload holds the data of nodes that can't be represented by strings
+// and children, like the function wrapped by Func. A field for the data in
+// Node would make every node two words larger, including the tags and
+// attributes that make up almost every tree, so the payload is allocated
+// together with the node's only child instead. Adding the field to Node took
+// BenchmarkSanityList1000 from 348272 to 396704 B/op, 14% more memory for a
+// tree that doesn't contain a single lazy node.
+type nodePayload struct {
+	// child must be the first field, so a pointer to child is also a pointer
+	// to the payload.
+	child Node
+	data  any
+}
+
+// withPayload returns the children of a node whose only child is child and
+// whose payload is data.
+func withPayload(child Node, data any) []Node {
+	p := &nodePayload{child: child, data: data}
+	return unsafe.Slice(&p.child, 1)
+}
+
+// payload returns the data of a node created by withPayload.
+//
+// nodePayload is larger than Node, so the conversion is outside of the
+// patterns documented by unsafe.Pointer. It is sound because the child of a
+// payload node is only ever created by withPayload: the pointer is converted
+// back to the type of the object it was allocated as, and the garbage
+// collector keeps the whole allocation alive while the child is reachable.
+// Callers must only use payload on node types created with withPayload.
+func (n *Node) payload() any {
+	return (*nodePayload)(unsafe.Pointer(&n.children[0])).data
+}
@@ -0,0 +1,59 @@
+package html
+
+// Query returns the first element in the tree that matches the CSS selector.
+// The node itself is included in the search. Elements are searched in
+// document order. Query is intended for tests and panics if the selector is
+// invalid. Use CompileSelector to handle invalid selectors.
+//
+// Example Usage:
+//
+//	list := tag.UL(attr.Class("fruit-list"), ForEach(fruits, fruitView))
+//	second, ok := Query(list, "ul.fruit-list > li:nth-child(2)")
+//	second.String() == "<li>orange</li>"
+func Query(node Node, selector string) (Node, bool) {
+	return mustCompileSelector(selector).Query(node)
+}
+
+// QueryAll returns every element in the tree that matches the CSS selector in
+// document order. Like Query, QueryAll panics if the selector is invalid.
+func QueryAll(node Node, selector string) []Node {
+	return mustCompileSelector(selector).QueryAll(node)
+}
+
+// Query returns the first element in the tree that matches the selector.
+func (s *Selector) Query(node Node) (Node, bool) {
+	for _, element := range newElementTree(node).elements {
+		if s.match(element) {
+			return *element.node, true
+		}
+	}
+	return Node{}, false
+}
+
+// QueryAll returns every element in the tree that matches the selector.
+func (s *Selector) QueryAll(node Node) []Node {
+	var result []Node
+	for _, element := range newElementTree(node).elements {
+		if s.match(element) {
+			result = append(result, *element.node)
+		}
+	}
+	return result
+}
+
+func (s *Selector) match(e *element) bool {
+	for _, selector := range s.selectors {
+		if selector.match(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func mustCompileSelector(selector string) *Selector {
+	compiled, err := CompileSelector(selector)
+	if err != nil {
+		panic(err)
+	}
+	return compiled
+}
@@ -0,0 +1,118 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func queryFixture() Node {
+	fruits := []string{"apple", "orange", "banana", "kiwi"}
+	return Document(
+		NewAttribute("lang", "en"),
+		NewTag("head", NewTag("title", InnerText("Fruit"))),
+		NewTag("body",
+			NewTag("h1", NewAttribute("id", "title"), InnerText("Fruit")),
+			NewTag("ul",
+				NewAttribute("class", "fruit-list primary"),
+				ForEach(fruits, func(fruit string) Node {
+					return NewTag("li", NewAttribute("data-name", fruit), InnerText(fruit))
+				}),
+			),
+			NewTag("p", NewAttribute("lang", "en-US"), InnerText("a & b")),
+			NewTag("p"),
+			NewVoidTag("input", NewAttribute("type", "TEXT"), NewBoolAttribute("disabled")),
+			NewTag("a", NewAttribute("href", "https://example.com/?a=1&b=2")),
+		),
+	)
+}
+
+func queryStrings(node Node, selector string) []string {
+	var result []string
+	for _, match := range QueryAll(node, selector) {
+		result = append(result, match.String())
+	}
+	return result
+}
+
+func TestQuery(t *testing.T) {
+	list := NewTag("ul",
+		NewAttribute("class", "fruit-list"),
+		NewTag("li", InnerText("apple")),
+		NewTag("li", InnerText("orange")),
+	)
+	second, ok := Query(list, "ul.fruit-list > li:nth-child(2)")
+	require.True(t, ok)
+	require.Equal(t, "<li>orange</li>", second.String())
+
+	_, ok = Query(list, "ol")
+	require.False(t, ok)
+}
+
+func TestQueryAll(t *testing.T) {
+	node := queryFixture()
+	type testCase struct {
+		selector string
+		result   []string
+	}
+	tests := []testCase{
+		{"title", []string{"<title>Fruit</title>"}},
+		{"TITLE", []string{"<title>Fruit</title>"}},
+		{"#title", []string{`<h1 id="title">Fruit</h1>`}},
+		{".fruit-list li:first-child", []string{`<li data-name="apple">apple</li>`}},
+		{".primary.fruit-list > li:last-child", []string{`<li data-name="kiwi">kiwi</li>`}},
+		{"li:nth-child(odd)", []string{`<li data-name="apple">apple</li>`, `<li data-name="banana">banana</li>`}},
+		{"li:nth-child(2n)", []string{`<li data-name="orange">orange</li>`, `<li data-name="kiwi">kiwi</li>`}},
+		{"li:nth-child(-n+1)", []string{`<li data-name="apple">apple</li>`}},
+		{"li:nth-last-child(2)", []string{`<li data-name="banana">banana</li>`}},
+		{"[data-name^=or]", []string{`<li data-name="orange">orange</li>`}},
+		{"[data-name$='ana']", []string{`<li data-name="banana">banana</li>`}},
+		{`[data-name*="iw"]`, []string{`<li data-name="kiwi">kiwi</li>`}},
+		{"[class~=primary]", []string{`<ul class="fruit-list primary"><li data-name="apple">apple</li><li data-name="orange">orange</li><li data-name="banana">banana</li><li data-name="kiwi">kiwi</li></ul>`}},
+		{"p[lang|=en]", []string{`<p lang="en-US">a &amp; b</p>`}},
+		{"[type=text i][disabled]", []string{`<input type="TEXT" disabled>`}},
+		{"[type=text]", nil},
+		{`a[href="https://example.com/?a=1&b=2"]`, []string{`<a href="https://example.com/?a=1&amp;b=2"></a>`}},
+		{"p:empty", []string{"<p></p>"}},
+		{"body > p:first-of-type", []string{`<p lang="en-US">a &amp; b</p>`}},
+		{"h1 + ul > li:nth-of-type(3)", []string{`<li data-name="banana">banana</li>`}},
+		{"h1 ~ a", []string{`<a href="https://example.com/?a=1&amp;b=2"></a>`}},
+		{"ul ~ h1", nil},
+		{"li:not(:first-child, [data-name=kiwi])", []string{`<li data-name="orange">orange</li>`, `<li data-name="banana">banana</li>`}},
+		{"html:root > head > title, h1", []string{"<title>Fruit</title>", `<h1 id="title">Fruit</h1>`}},
+		{"head > *", []string{"<title>Fruit</title>"}},
+		{"body > li", nil},
+		{"html li:only-child", nil},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.result, queryStrings(node, tc.selector), tc.selector)
+	}
+}
+
+func TestQueryFragment(t *testing.T) {
+	node := Combine(
+		NewTag("span", InnerText("one")),
+		NewTag("span", InnerText("two")),
+	)
+	require.Equal(t, []string{"<span>one</span>"}, queryStrings(node, "span:first-child"))
+	require.Equal(t, []string{"<span>two</span>"}, queryStrings(node, "span + span"))
+}
+
+func TestCompileSelectorError(t *testing.T) {
+	invalid := []string{
+		"",
+		"ul >",
+		"ul,",
+		"[href",
+		"[href=]",
+		"li:nth-child(x)",
+		"li:hover",
+		":not(li",
+		"a$",
+	}
+	for _, selector := range invalid {
+		_, err := CompileSelector(selector)
+		require.Error(t, err, selector)
+	}
+	require.Panics(t, func() { Query(Node{}, "[") })
+}
@@ -1,26 +1,109 @@
 package html
 
-// renderVisitor is the core implementation of Node.Render.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// renderVisitor is the core implementation of Node.Render and Node.RenderTo.
 type renderVisitor struct {
 	bytes []byte
+
+	// out is only set when streaming. When out is nil, Flush nodes are
+	// ignored and the caller collects the rendered bytes.
+	out io.Writer
+	// err is the first error encountered while rendering. Once the render
+	// fails, the rest of the render is discarded.
+	err error
+
+	// ctx is nil unless the render was started with a context. done is
+	// cached because ctx.Done() is checked for every tag.
+	ctx  context.Context
+	done <-chan struct{}
+
+	// ignoreErrors is set by Node.Render, which has no way to report
+	// errors. Failed Try nodes outside of an ErrorBoundary are skipped.
+	ignoreErrors bool
+	// boundaries is the number of ErrorBoundary nodes being rendered. Output
+	// is not flushed inside of a boundary because it may be replaced by the
+	// fallback.
+	boundaries int
+
+	// workers is a semaphore that bounds the number of goroutines used to
+	// render ForEachParallel nodes. It is nil unless the Parallel option is
+	// used.
+	workers chan struct{}
+
+	// panicked and panicValue record a panic raised while rendering a
+	// parallel item, so it can be re-raised on the rendering goroutine.
+	panicked   bool
+	panicValue any
+
+	// cache stores the output of Cached nodes. If cache is nil, DefaultCache
+	// is used.
+	cache Cache
+	// incomplete is set when an error is skipped because of ignoreErrors or
+	// replaced by the fallback of an ErrorBoundary. The output is still
+	// written, but a Cached fragment with incomplete output is not stored.
+	incomplete bool
+	// headContent is set when a HeadContent node is rendered. A Cached
+	// fragment with HeadContent is not stored.
+	headContent bool
+
+	// strict reports misuse of the library as errors. See SetStrict.
+	strict bool
+
+	flushAfterHead bool
+
+	// document is set while a Document is rendered. See HeadContent.
+	document *documentState
+}
+
+func newRenderVisitor(ctx context.Context) *renderVisitor {
+	return &renderVisitor{ctx: ctx, done: ctx.Done(), strict: strict.Load()}
 }
 
 func (rv *renderVisitor) Tag(name string, node *Node) {
+	if rv.failed() || rv.strict && rv.checkStrict(name, node) {
+		return
+	}
+
 	rv.write("<")
 	rv.write(name)
 
 	node.VisitAttributes(rv)
 
+	if node.nodeType == nodeTypeForeignTag && onlyAttributes(node.children) {
+		rv.write("/>")
+		return
+	}
 	rv.write(">")
 
+	if rv.document != nil {
+		rv.document.enter(name, len(rv.bytes))
+	}
 	node.VisitChildren(rv)
+	if rv.document != nil {
+		rv.document.leave(name, len(rv.bytes))
+	}
 
 	rv.write("</")
 	rv.write(name)
 	rv.write(">")
+
+	if rv.flushAfterHead && name == "head" {
+		rv.Flush()
+	}
 }
 
 func (rv *renderVisitor) VoidTag(name string, node *Node) {
+	if rv.failed() || rv.strict && rv.checkStrict(name, node) {
+		return
+	}
+
 	rv.write("<")
 	rv.write(name)
 
@@ -30,6 +113,9 @@ func (rv *renderVisitor) VoidTag(name string, node *Node) {
 }
 
 func (rv *renderVisitor) Content(content string) {
+	if rv.err != nil {
+		return
+	}
 	rv.write(content)
 }
 
@@ -43,6 +129,387 @@ func (rv *renderVisitor) Attribute(name string, value *string) {
 	}
 }
 
+// onlyAttributes returns true if the nodes don't render any content, so a
+// foreign element can be self-closing. Lazy nodes count as content, because
+// they can't be evaluated twice.
+func onlyAttributes(nodes []Node) bool {
+	for i := range nodes {
+		switch nodes[i].nodeType {
+		case nodeTypeEmpty, nodeTypeAttr, nodeTypeBoolAttr, nodeTypeInvalid, nodeTypeCallSite:
+		case nodeTypeMany:
+			if !onlyAttributes(nodes[i].children) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Context returns the context passed to lazy nodes.
+func (rv *renderVisitor) Context() context.Context {
+	if rv.ctx == nil {
+		return context.Background()
+	}
+	return rv.ctx
+}
+
+// Flush writes the buffered bytes to the output and flushes the output if it
+// supports flushing. This is how a Flush node is rendered.
+func (rv *renderVisitor) Flush() {
+	if rv.out == nil || rv.boundaries != 0 || rv.failed() {
+		return
+	}
+	if d := rv.document; d != nil && !d.headWritten {
+		if d.headOpen && d.headEnd < 0 {
+			// The head is incomplete, so the output can't be written
+			// until it is closed.
+			return
+		}
+		rv.writeHead()
+		if rv.failed() {
+			return
+		}
+	}
+	rv.writeOut()
+	switch flusher := rv.out.(type) {
+	case interface{ Flush() error }:
+		if err := flusher.Flush(); err != nil && rv.err == nil {
+			rv.err = err
+		}
+	case interface{ Flush() }:
+		flusher.Flush()
+	}
+}
+
+// checkStrict records an error and returns true if the tag misuses the
+// library.
+func (rv *renderVisitor) checkStrict(name string, node *Node) bool {
+	err := checkStrict(name, node)
+	if err == nil {
+		return false
+	}
+	rv.strictFailed(err)
+	return true
+}
+
+// Invalid reports nodes created with an invalid name in strict mode. Outside
+// of strict mode invalid nodes render as nothing.
+func (rv *renderVisitor) Invalid(node *Node) {
+	if rv.strict && !rv.failed() {
+		rv.strictFailed(strictError(node, "%s", node.str1))
+	}
+}
+
+// strictFailed records a strict mode error. Node.Render can't return the
+// error, so it panics instead.
+func (rv *renderVisitor) strictFailed(err error) {
+	if rv.ignoreErrors && rv.boundaries == 0 {
+		panic(err)
+	}
+	rv.err = err
+}
+
+// Error records an error returned by a Try node.
+func (rv *renderVisitor) Error(err error) {
+	if rv.ignoreErrors && rv.boundaries == 0 {
+		rv.incomplete = true
+		return
+	}
+	if rv.err == nil {
+		rv.err = err
+	}
+}
+
+// ErrorBoundary renders the child. If the child fails or panics, its output
+// is discarded and the fallback is rendered instead.
+func (rv *renderVisitor) ErrorBoundary(child *Node, fallback func(error) Node) {
+	if rv.failed() {
+		return
+	}
+
+	start := len(rv.bytes)
+	var document documentState
+	if rv.document != nil {
+		document = *rv.document
+	}
+	rv.boundaries++
+	err := rv.tryVisit(child)
+	rv.boundaries--
+
+	if err == nil || rv.ctx != nil && rv.ctx.Err() == err {
+		// Cancellation is not something the fallback can fix.
+		return
+	}
+	if isStrictError(err) || errors.Is(err, ErrHeadFlushed) {
+		// Strict mode errors are meant to be loud, and the fallback can't
+		// move HeadContent into a head that was already written.
+		if rv.ignoreErrors && rv.boundaries == 0 {
+			panic(err)
+		}
+		return
+	}
+
+	rv.bytes = rv.bytes[:start]
+	if rv.document != nil {
+		// The HeadContent of the failed child is discarded with its output.
+		*rv.document = document
+	}
+	rv.err = nil
+	rv.incomplete = true
+	node := fallback(err)
+	node.visitAsContent(rv)
+}
+
+// tryVisit renders the node and converts panics into errors.
+func (rv *renderVisitor) tryVisit(node *Node) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(error); ok {
+				err = fmt.Errorf("html: panic while rendering: %w", rErr)
+			} else {
+				err = fmt.Errorf("html: panic while rendering: %v", r)
+			}
+		}
+	}()
+	node.visitAsContent(rv)
+	return rv.err
+}
+
+// Parallel renders the items of a ForEachParallel node. Each item is rendered
+// into its own buffer. Items are handed to a worker goroutine while the pool
+// has capacity and are rendered by the calling goroutine otherwise, so nested
+// parallel nodes can't deadlock waiting for the pool.
+func (rv *renderVisitor) Parallel(items lazyItems) {
+	if rv.failed() {
+		return
+	}
+	if rv.workers == nil {
+		for i := 0; i < items.count; i++ {
+			item := items.view(i)
+			item.visitAsContent(rv)
+		}
+		return
+	}
+
+	parts := make([]renderVisitor, items.count)
+	var wg sync.WaitGroup
+	for i := range parts {
+		parts[i] = rv.fork()
+		select {
+		case rv.workers <- struct{}{}:
+			wg.Add(1)
+			go func(part *renderVisitor, i int) {
+				defer wg.Done()
+				defer func() { <-rv.workers }()
+				part.renderItem(items, i)
+			}(&parts[i], i)
+		default:
+			parts[i].renderItem(items, i)
+		}
+	}
+	wg.Wait()
+
+	for i := range parts {
+		if parts[i].panicked {
+			panic(parts[i].panicValue)
+		}
+		rv.bytes = append(rv.bytes, parts[i].bytes...)
+		rv.incomplete = rv.incomplete || parts[i].incomplete
+		rv.join(&parts[i])
+		if parts[i].err != nil {
+			rv.err = parts[i].err
+			return
+		}
+	}
+}
+
+// Cached renders a Cached node. On a cache miss the fragment is rendered into
+// its own buffer so the bytes can be stored. Fragments that fail are not
+// cached, even if the failure is skipped by Node.Render or recovered by an
+// ErrorBoundary inside of the fragment.
+func (rv *renderVisitor) Cached(key string, fragment cachedFragment) {
+	if rv.failed() {
+		return
+	}
+	cache := rv.cache
+	if cache == nil {
+		cache = DefaultCache
+	}
+	if bytes, ok := cache.Get(key); ok {
+		rv.bytes = append(rv.bytes, bytes...)
+		return
+	}
+
+	part := rv.fork()
+	node := fragment.build()
+	node.visitAsContent(&part)
+	if part.err != nil {
+		rv.err = part.err
+		return
+	}
+	switch {
+	case part.incomplete:
+		// The fragment failed, but the error was skipped by Node.Render or
+		// replaced by an ErrorBoundary's fallback. The output is used for
+		// this render only.
+		rv.incomplete = true
+	case part.headContent:
+		// The cache only stores the bytes. A hit would lose HeadContent
+		// collected by a Document, or replay HeadContent that was rendered
+		// in place without a Document into the body of one.
+	default:
+		cache.Set(key, part.bytes, fragment.ttl)
+	}
+	rv.join(&part)
+	rv.bytes = append(rv.bytes, part.bytes...)
+}
+
+// fork creates a visitor for rendering a parallel item or a cached fragment.
+// The fork shares the render's configuration, but has its own buffer and
+// never writes to the output. Inside of a Document, the fork collects its
+// HeadContent, which is added to the document by join.
+func (rv *renderVisitor) fork() renderVisitor {
+	part := renderVisitor{
+		ctx:          rv.ctx,
+		done:         rv.done,
+		ignoreErrors: rv.ignoreErrors,
+		boundaries:   rv.boundaries,
+		workers:      rv.workers,
+		cache:        rv.cache,
+		strict:       rv.strict,
+	}
+	if rv.document != nil {
+		part.document = newDocumentState()
+		part.document.headWritten = rv.document.headWritten
+	}
+	return part
+}
+
+// join adds the HeadContent collected by a fork to the document.
+func (rv *renderVisitor) join(part *renderVisitor) {
+	rv.headContent = rv.headContent || part.headContent
+	if part.document != nil {
+		rv.document.head = append(rv.document.head, part.document.head...)
+	}
+}
+
+// Document renders the document and inserts the HeadContent collected while
+// rendering it into the <head>.
+func (rv *renderVisitor) Document(node *Node) {
+	if rv.failed() {
+		return
+	}
+	outer := rv.document
+	rv.document = newDocumentState()
+	node.VisitChildren(rv)
+	if !rv.document.headWritten {
+		rv.writeHead()
+	}
+	rv.document = outer
+}
+
+// HeadContent collects the node if it is inside of a Document and renders it
+// in place otherwise. HeadContent rendered after the <head> was written to
+// the output fails the render.
+func (rv *renderVisitor) HeadContent(node *Node) {
+	if rv.failed() {
+		return
+	}
+	rv.headContent = true
+	switch d := rv.document; {
+	case d == nil:
+		node.VisitChildren(rv)
+	case d.headWritten && rv.strict:
+		rv.strictFailed(strictError(node, "HeadContent %q is rendered after the <head> was flushed", node.str1))
+	case d.headWritten:
+		rv.err = fmt.Errorf("%w: HeadContent %q", ErrHeadFlushed, node.str1)
+	default:
+		d.head = append(d.head, *node)
+	}
+}
+
+// writeHead renders the collected HeadContent and inserts it at the end of
+// the document's <head>. If the document has no <head>, one is inserted at
+// the start of <html>.
+func (rv *renderVisitor) writeHead() {
+	d := rv.document
+	d.headWritten = true
+	content := resolveHeadContent(d.head)
+	if len(content) == 0 || rv.failed() {
+		return
+	}
+
+	part := rv.fork()
+	part.document = nil
+	if d.headEnd < 0 {
+		part.write("<head>")
+	}
+	for i := range content {
+		content[i].visitAsContent(&part)
+	}
+	if d.headEnd < 0 {
+		part.write("</head>")
+	}
+	if part.err != nil {
+		rv.err = part.err
+		return
+	}
+	rv.incomplete = rv.incomplete || part.incomplete
+
+	offset := d.headEnd
+	if offset < 0 {
+		offset = d.htmlStart
+	}
+	if offset < 0 {
+		return
+	}
+	end := len(rv.bytes)
+	rv.bytes = append(rv.bytes, part.bytes...)
+	copy(rv.bytes[offset+len(part.bytes):], rv.bytes[offset:end])
+	copy(rv.bytes[offset:], part.bytes)
+}
+
+func (rv *renderVisitor) renderItem(items lazyItems, i int) {
+	defer func() {
+		if r := recover(); r != nil {
+			rv.panicked = true
+			rv.panicValue = r
+		}
+	}()
+	item := items.view(i)
+	item.visitAsContent(rv)
+}
+
+// writeOut writes the buffered bytes to the output and resets the buffer.
+func (rv *renderVisitor) writeOut() {
+	if rv.err != nil || len(rv.bytes) == 0 {
+		return
+	}
+	_, rv.err = rv.out.Write(rv.bytes)
+	rv.bytes = rv.bytes[:0]
+}
+
+// failed returns true if the render encountered an error or the render's
+// context was canceled.
+func (rv *renderVisitor) failed() bool {
+	if rv.err != nil {
+		return true
+	}
+	if rv.done == nil {
+		// The render has no context or its context can't be canceled.
+		return false
+	}
+	select {
+	case <-rv.done:
+		rv.err = rv.ctx.Err()
+		return true
+	default:
+		return false
+	}
+}
+
 func (rv *renderVisitor) write(str string) {
 	rv.bytes = append(rv.bytes, str...)
 }
@@ -0,0 +1,523 @@
+package html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Selector is a compiled CSS selector. Selectors are created by
+// CompileSelector and may be used to query many Node trees.
+//
+// The supported syntax is:
+//   - type and universal selectors: `ul`, `*`
+//   - id and class selectors: `#main`, `.article`
+//   - attribute selectors: `[href]`, `[type=text]`, `[class~=a]`, `[lang|=en]`,
+//     `[href^="https:"]`, `[src$=".png"]`, `[title*=news]`, `[type=TEXT i]`
+//   - combinators: descendant (` `), child (`>`), next sibling (`+`), and
+//     subsequent sibling (`~`)
+//   - pseudo-classes: `:root`, `:empty`, `:first-child`, `:last-child`,
+//     `:only-child`, `:first-of-type`, `:last-of-type`, `:only-of-type`,
+//     `:nth-child()`, `:nth-last-child()`, `:nth-of-type()`,
+//     `:nth-last-of-type()`, and `:not()`
+//   - selector lists: `h1, h2`
+type Selector struct {
+	source    string
+	selectors []complexSelector
+}
+
+// String returns the source of the selector.
+func (s *Selector) String() string {
+	return s.source
+}
+
+// complexSelector is a sequence of compound selectors joined by combinators.
+// combinators[i] joins compounds[i] and compounds[i+1].
+type complexSelector struct {
+	compounds   []compoundSelector
+	combinators []byte
+}
+
+// compoundSelector matches an element if all of its matchers match.
+type compoundSelector []elementMatcher
+
+type elementMatcher func(e *element) bool
+
+func (c complexSelector) match(e *element) bool {
+	return c.matchAt(len(c.compounds)-1, e)
+}
+
+func (c complexSelector) matchAt(i int, e *element) bool {
+	if !c.compounds[i].match(e) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch c.combinators[i-1] {
+	case '>':
+		return !e.parent.isRoot() && c.matchAt(i-1, e.parent)
+	case '+':
+		previous := e.previousSibling()
+		return previous != nil && c.matchAt(i-1, previous)
+	case '~':
+		for sibling := e.previousSibling(); sibling != nil; sibling = sibling.previousSibling() {
+			if c.matchAt(i-1, sibling) {
+				return true
+			}
+		}
+		return false
+	default:
+		for ancestor := e.parent; !ancestor.isRoot(); ancestor = ancestor.parent {
+			if c.matchAt(i-1, ancestor) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (c compoundSelector) match(e *element) bool {
+	for _, matcher := range c {
+		if !matcher(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompileSelector parses a CSS selector. See Selector for the supported
+// syntax.
+func CompileSelector(selector string) (*Selector, error) {
+	p := selectorParser{input: selector}
+	selectors, err := p.parseList()
+	if err != nil {
+		return nil, fmt.Errorf("html: invalid selector %q: %w", selector, err)
+	}
+	return &Selector{source: selector, selectors: selectors}, nil
+}
+
+// selectorParser is a recursive descent parser for CSS selectors.
+type selectorParser struct {
+	input string
+	pos   int
+}
+
+func (p *selectorParser) parseList() ([]complexSelector, error) {
+	var selectors []complexSelector
+	for {
+		p.skipSpace()
+		selector, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		p.skipSpace()
+		if p.done() {
+			return selectors, nil
+		}
+		if p.peek() != ',' {
+			return nil, p.errorf("unexpected %q", p.peek())
+		}
+		p.pos++
+	}
+}
+
+func (p *selectorParser) parseComplex() (complexSelector, error) {
+	var selector complexSelector
+	compound, err := p.parseCompound()
+	if err != nil {
+		return selector, err
+	}
+	selector.compounds = append(selector.compounds, compound)
+	for {
+		hadSpace := p.skipSpace()
+		if p.done() || p.peek() == ',' || p.peek() == ')' {
+			return selector, nil
+		}
+		combinator := byte(' ')
+		switch p.peek() {
+		case '>', '+', '~':
+			combinator = p.peek()
+			p.pos++
+			p.skipSpace()
+		default:
+			if !hadSpace {
+				return selector, p.errorf("unexpected %q", p.peek())
+			}
+		}
+		compound, err := p.parseCompound()
+		if err != nil {
+			return selector, err
+		}
+		selector.compounds = append(selector.compounds, compound)
+		selector.combinators = append(selector.combinators, combinator)
+	}
+}
+
+func (p *selectorParser) parseCompound() (compoundSelector, error) {
+	var compound compoundSelector
+	if !p.done() && p.peek() == '*' {
+		p.pos++
+		compound = append(compound, func(e *element) bool { return true })
+	} else if !p.done() && isIdentStart(p.peek()) {
+		name := strings.ToLower(p.parseIdent())
+		compound = append(compound, func(e *element) bool { return e.name == name })
+	}
+	for !p.done() {
+		var matcher elementMatcher
+		var err error
+		switch p.peek() {
+		case '#':
+			p.pos++
+			matcher, err = p.parseID()
+		case '.':
+			p.pos++
+			matcher, err = p.parseClass()
+		case '[':
+			p.pos++
+			matcher, err = p.parseAttribute()
+		case ':':
+			p.pos++
+			matcher, err = p.parsePseudo()
+		default:
+			if len(compound) == 0 {
+				return nil, p.errorf("expected a selector")
+			}
+			return compound, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		compound = append(compound, matcher)
+	}
+	if len(compound) == 0 {
+		return nil, p.errorf("expected a selector")
+	}
+	return compound, nil
+}
+
+func (p *selectorParser) parseID() (elementMatcher, error) {
+	id := p.parseIdent()
+	if id == "" {
+		return nil, p.errorf("expected an id")
+	}
+	return func(e *element) bool {
+		value, ok := e.attribute("id")
+		return ok && value == id
+	}, nil
+}
+
+func (p *selectorParser) parseClass() (elementMatcher, error) {
+	class := p.parseIdent()
+	if class == "" {
+		return nil, p.errorf("expected a class name")
+	}
+	return func(e *element) bool {
+		value, ok := e.attribute("class")
+		return ok && containsWord(value, class)
+	}, nil
+}
+
+func (p *selectorParser) parseAttribute() (elementMatcher, error) {
+	p.skipSpace()
+	name := strings.ToLower(p.parseIdent())
+	if name == "" {
+		return nil, p.errorf("expected an attribute name")
+	}
+	p.skipSpace()
+	if p.done() {
+		return nil, p.errorf("expected ']'")
+	}
+	if p.peek() == ']' {
+		p.pos++
+		return func(e *element) bool {
+			_, ok := e.attribute(name)
+			return ok
+		}, nil
+	}
+
+	var op byte = '='
+	switch p.peek() {
+	case '~', '|', '^', '$', '*':
+		op = p.peek()
+		p.pos++
+	}
+	if p.done() || p.peek() != '=' {
+		return nil, p.errorf("expected '='")
+	}
+	p.pos++
+	p.skipSpace()
+
+	var expected string
+	if !p.done() && (p.peek() == '"' || p.peek() == '\'') {
+		var err error
+		if expected, err = p.parseString(); err != nil {
+			return nil, err
+		}
+	} else if expected = p.parseIdent(); expected == "" {
+		return nil, p.errorf("expected an attribute value")
+	}
+	p.skipSpace()
+
+	ignoreCase := false
+	if !p.done() && (p.peek() == 'i' || p.peek() == 'I') {
+		ignoreCase = true
+		p.pos++
+		p.skipSpace()
+	}
+	if p.done() || p.peek() != ']' {
+		return nil, p.errorf("expected ']'")
+	}
+	p.pos++
+
+	if ignoreCase {
+		expected = strings.ToLower(expected)
+	}
+	return func(e *element) bool {
+		value, ok := e.attribute(name)
+		if !ok {
+			return false
+		}
+		if ignoreCase {
+			value = strings.ToLower(value)
+		}
+		switch op {
+		case '~':
+			return containsWord(value, expected)
+		case '|':
+			return value == expected || strings.HasPrefix(value, expected+"-")
+		case '^':
+			return expected != "" && strings.HasPrefix(value, expected)
+		case '$':
+			return expected != "" && strings.HasSuffix(value, expected)
+		case '*':
+			return expected != "" && strings.Contains(value, expected)
+		default:
+			return value == expected
+		}
+	}, nil
+}
+
+func (p *selectorParser) parsePseudo() (elementMatcher, error) {
+	name := strings.ToLower(p.parseIdent())
+	switch name {
+	case "root":
+		return func(e *element) bool { return e.parent.isRoot() }, nil
+	case "empty":
+		return func(e *element) bool { return len(e.children) == 0 && !e.hasText }, nil
+	case "first-child":
+		return nthMatcher(0, 1, false, false), nil
+	case "last-child":
+		return nthMatcher(0, 1, true, false), nil
+	case "only-child":
+		first, last := nthMatcher(0, 1, false, false), nthMatcher(0, 1, true, false)
+		return func(e *element) bool { return first(e) && last(e) }, nil
+	case "first-of-type":
+		return nthMatcher(0, 1, false, true), nil
+	case "last-of-type":
+		return nthMatcher(0, 1, true, true), nil
+	case "only-of-type":
+		first, last := nthMatcher(0, 1, false, true), nthMatcher(0, 1, true, true)
+		return func(e *element) bool { return first(e) && last(e) }, nil
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type":
+		argument, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		a, b, err := parseNth(argument)
+		if err != nil {
+			return nil, p.errorf("%w", err)
+		}
+		fromEnd := strings.Contains(name, "last")
+		ofType := strings.HasSuffix(name, "of-type")
+		return nthMatcher(a, b, fromEnd, ofType), nil
+	case "not":
+		if p.done() || p.peek() != '(' {
+			return nil, p.errorf("expected '('")
+		}
+		p.pos++
+		selectors, err := p.parseNested()
+		if err != nil {
+			return nil, err
+		}
+		return func(e *element) bool {
+			for _, selector := range selectors {
+				if selector.match(e) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case "":
+		return nil, p.errorf("expected a pseudo-class")
+	default:
+		return nil, p.errorf("unsupported pseudo-class %q", name)
+	}
+}
+
+// parseNested parses the selector list inside of a functional pseudo-class
+// like :not().
+func (p *selectorParser) parseNested() ([]complexSelector, error) {
+	var selectors []complexSelector
+	for {
+		p.skipSpace()
+		selector, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		p.skipSpace()
+		if p.done() {
+			return nil, p.errorf("expected ')'")
+		}
+		switch p.peek() {
+		case ')':
+			p.pos++
+			return selectors, nil
+		case ',':
+			p.pos++
+		default:
+			return nil, p.errorf("unexpected %q", p.peek())
+		}
+	}
+}
+
+// parseArgument returns the raw text between the parenthesis of a functional
+// pseudo-class.
+func (p *selectorParser) parseArgument() (string, error) {
+	if p.done() || p.peek() != '(' {
+		return "", p.errorf("expected '('")
+	}
+	end := strings.IndexByte(p.input[p.pos:], ')')
+	if end == -1 {
+		return "", p.errorf("expected ')'")
+	}
+	argument := p.input[p.pos+1 : p.pos+end]
+	p.pos += end + 1
+	return strings.TrimSpace(argument), nil
+}
+
+func (p *selectorParser) parseIdent() string {
+	var ident strings.Builder
+	for !p.done() {
+		c := p.peek()
+		switch {
+		case c == '\\' && p.pos+1 < len(p.input):
+			ident.WriteByte(p.input[p.pos+1])
+			p.pos += 2
+		case isIdentStart(c) || c >= '0' && c <= '9' || c == '-':
+			ident.WriteByte(c)
+			p.pos++
+		default:
+			return ident.String()
+		}
+	}
+	return ident.String()
+}
+
+func (p *selectorParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var value strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return value.String(), nil
+		case c == '\\' && !p.done():
+			value.WriteByte(p.peek())
+			p.pos++
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for !p.done() && strings.IndexByte(" \t\n\r\f", p.peek()) != -1 {
+		p.pos++
+	}
+	return p.pos != start
+}
+
+func (p *selectorParser) done() bool {
+	return len(p.input) <= p.pos
+}
+
+func (p *selectorParser) peek() byte {
+	return p.input[p.pos]
+}
+
+func (p *selectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("offset %d: "+format, append([]any{p.pos}, args...)...)
+}
+
+func isIdentStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+// parseNth parses the an+b syntax used by :nth-child() and friends.
+func parseNth(argument string) (a int, b int, err error) {
+	argument = strings.ToLower(strings.ReplaceAll(argument, " ", ""))
+	switch argument {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+	n := strings.IndexByte(argument, 'n')
+	if n == -1 {
+		b, err = strconv.Atoi(argument)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", argument)
+		}
+		return 0, b, nil
+	}
+	switch coefficient := argument[:n]; coefficient {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		if a, err = strconv.Atoi(coefficient); err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", argument)
+		}
+	}
+	if offset := argument[n+1:]; offset != "" {
+		if offset[0] != '+' && offset[0] != '-' {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", argument)
+		}
+		if b, err = strconv.Atoi(offset); err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", argument)
+		}
+	}
+	return a, b, nil
+}
+
+// nthMatcher matches elements whose 1-based position among their siblings is
+// a*n+b for some n >= 0.
+func nthMatcher(a, b int, fromEnd bool, ofType bool) elementMatcher {
+	return func(e *element) bool {
+		position := e.position(fromEnd, ofType)
+		if a == 0 {
+			return position == b
+		}
+		diff := position - b
+		return diff/a >= 0 && diff%a == 0
+	}
+}
+
+// containsWord returns true if word is one of the whitespace separated words
+// in list.
+func containsWord(list string, word string) bool {
+	for _, field := range strings.Fields(list) {
+		if field == word {
+			return true
+		}
+	}
+	return false
+}
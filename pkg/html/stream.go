@@ -0,0 +1,76 @@
+package html
+
+import (
+	"context"
+	"io"
+)
+
+// RenderOption configures Node.RenderTo.
+type RenderOption func(*renderVisitor)
+
+// FlushAfterHead flushes the output after the closing </head> tag is
+// rendered. Flushing after the head allows the browser to start fetching
+// stylesheets and scripts while the body is still being rendered.
+//
+// The head is written as soon as it is closed, so HeadContent placed in the
+// <body> of a Document can't be added to it and RenderTo fails with
+// ErrHeadFlushed. Don't use FlushAfterHead for documents whose body contains
+// HeadContent.
+func FlushAfterHead() RenderOption {
+	return func(rv *renderVisitor) {
+		rv.flushAfterHead = true
+	}
+}
+
+// WithContext passes the context to the lazy nodes created by Func. If the
+// context is canceled, e.g. because the client disconnected, rendering stops
+// and RenderTo returns the context's error.
+func WithContext(ctx context.Context) RenderOption {
+	return func(rv *renderVisitor) {
+		rv.ctx = ctx
+		rv.done = ctx.Done()
+	}
+}
+
+// Parallel renders the items of ForEachParallel nodes concurrently using at
+// most the given number of goroutines. Parallel(1) renders serially.
+func Parallel(workers int) RenderOption {
+	return func(rv *renderVisitor) {
+		if workers > 1 {
+			// The goroutine calling RenderTo is one of the workers.
+			rv.workers = make(chan struct{}, workers-1)
+		}
+	}
+}
+
+// WithCache stores the output of Cached nodes in the cache instead of
+// DefaultCache.
+func WithCache(cache Cache) RenderOption {
+	return func(rv *renderVisitor) {
+		rv.cache = cache
+	}
+}
+
+// RenderTo renders the node and streams the result to the writer. Output is
+// buffered and written at each Flush node and once the render is complete.
+// If the writer implements http.Flusher, it is flushed at every flush point
+// so the client receives the content as soon as it is written.
+//
+// Example Usage:
+//
+//	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//		err := indexDocument().RenderTo(w,
+//			html.FlushAfterHead(),
+//			html.WithContext(r.Context()),
+//		)
+//		...
+//	})
+func (n Node) RenderTo(w io.Writer, options ...RenderOption) error {
+	renderer := &renderVisitor{out: w, strict: strict.Load()}
+	for _, option := range options {
+		option(renderer)
+	}
+	n.Visit(renderer)
+	renderer.writeOut()
+	return renderer.err
+}
@@ -0,0 +1,94 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// chunkWriter records the content that was written between each flush.
+type chunkWriter struct {
+	buffer bytes.Buffer
+	chunks []string
+}
+
+func (c *chunkWriter) Write(b []byte) (int, error) {
+	return c.buffer.Write(b)
+}
+
+func (c *chunkWriter) Flush() {
+	c.chunks = append(c.chunks, c.buffer.String())
+	c.buffer.Reset()
+}
+
+type failingWriter struct {
+	writes int
+}
+
+func (f *failingWriter) Write(b []byte) (int, error) {
+	f.writes++
+	return 0, errors.New("connection closed")
+}
+
+func TestRenderTo(t *testing.T) {
+	var buffer bytes.Buffer
+	node := NewTag("div", InnerText("hello"), Flush(), InnerText("world"))
+	require.NoError(t, node.RenderTo(&buffer))
+	require.Equal(t, "<div>helloworld</div>", buffer.String())
+}
+
+func TestRenderToFlush(t *testing.T) {
+	writer := &chunkWriter{}
+	node := NewTag("ul",
+		NewTag("li", InnerText("one")),
+		Flush(),
+		NewTag("li", InnerText("two")),
+		Flush(),
+		NewTag("li", InnerText("three")),
+	)
+	require.NoError(t, node.RenderTo(writer))
+	require.Equal(t, []string{"<ul><li>one</li>", "<li>two</li>"}, writer.chunks)
+	require.Equal(t, "<li>three</li></ul>", writer.buffer.String())
+}
+
+func TestRenderToFlushAfterHead(t *testing.T) {
+	writer := &chunkWriter{}
+	node := Document(
+		NewTag("head", NewTag("title", InnerText("title"))),
+		NewTag("body", InnerText("body")),
+	)
+	require.NoError(t, node.RenderTo(writer, FlushAfterHead()))
+	require.Equal(t, []string{"<!DOCTYPE html><html><head><title>title</title></head>"}, writer.chunks)
+	require.Equal(t, "<body>body</body></html>", writer.buffer.String())
+
+	writer = &chunkWriter{}
+	require.NoError(t, node.RenderTo(writer))
+	require.Empty(t, writer.chunks)
+}
+
+func TestRenderToError(t *testing.T) {
+	writer := &failingWriter{}
+	node := NewTag("div", InnerText("hello"), Flush(), InnerText("world"), Flush())
+	require.EqualError(t, node.RenderTo(writer), "connection closed")
+	require.Equal(t, 1, writer.writes)
+}
+
+func TestRenderToContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	writer := &chunkWriter{}
+	node := NewTag("div",
+		InnerText("sent"),
+		Flush(),
+		Func(func(ctx context.Context) Node {
+			cancel()
+			return InnerText("dropped")
+		}),
+		NewTag("span"),
+	)
+	require.ErrorIs(t, node.RenderTo(writer, WithContext(ctx)), context.Canceled)
+	require.Equal(t, []string{"<div>sent"}, writer.chunks)
+	require.Empty(t, writer.buffer.String())
+}
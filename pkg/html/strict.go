@@ -0,0 +1,197 @@
+package html
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// strict is set by SetStrict.
+var strict atomic.Bool
+
+// SetStrict enables or disables strict mode for the whole program. Strict
+// mode is intended for development. In strict mode:
+//   - attributes that appear after content, which are normally hoisted to
+//     the start of the tag,
+//   - children passed to void tags, which are normally dropped,
+//   - tags and attributes with invalid names, which normally render as
+//     nothing,
+//   - unknown tag names,
+//   - and SVG and MathML elements created by NewTag instead of
+//     NewForeignTag
+//
+// are reported as a *StrictError. Node.Render panics with the error while
+// Node.RenderContext and Node.RenderTo return it. Errors in strict mode are
+// never recovered by an ErrorBoundary.
+//
+// While strict mode is enabled, tags and attributes record the location of
+// the code that created them, so errors point at the offending component.
+// Recording the location is expensive, so strict mode should be disabled in
+// production.
+func SetStrict(enabled bool) {
+	strict.Store(enabled)
+}
+
+// Strict enables strict mode for a single render. See SetStrict. Call sites
+// are only included in errors if the nodes were created while SetStrict was
+// enabled.
+func Strict() RenderOption {
+	return func(rv *renderVisitor) {
+		rv.strict = true
+	}
+}
+
+// StrictError describes misuse of the library detected in strict mode.
+type StrictError struct {
+	Message string
+	// CallSite is the function, file, and line of the code that created the
+	// offending node. CallSite is empty unless the node was created while
+	// SetStrict was enabled.
+	CallSite string
+}
+
+func (e *StrictError) Error() string {
+	if e.CallSite == "" {
+		return "html: strict: " + e.Message
+	}
+	return "html: strict: " + e.Message + " (created by " + e.CallSite + ")"
+}
+
+// callSite records where a node was created. It is stored in the payload of
+// a hidden nodeTypeCallSite child, which is appended to the children of nodes
+// created while strict mode is enabled. Visitors skip the hidden child.
+type callSite struct {
+	function string
+	file     string
+	line     int
+}
+
+func (c *callSite) String() string {
+	return c.function + " at " + c.file + ":" + strconv.Itoa(c.line)
+}
+
+// libraryPrefix identifies the packages in this module. Frames in library
+// code are skipped when recording a call site, so the call site is the
+// component that called tag.Div instead of tag.Div itself.
+const libraryPrefix = "github.com/jeffswenson/sanity/pkg/"
+
+// withCallSite appends the call site of the node to its children if strict
+// mode is enabled. Outside of strict mode it returns the children unchanged,
+// so recording call sites costs nothing.
+func withCallSite(children []Node) []Node {
+	site := recordCallSite()
+	if site == nil {
+		return children
+	}
+	// The full slice expression makes append copy the children instead of
+	// writing into the caller's array.
+	return append(children[:len(children):len(children)], Node{
+		nodeType: nodeTypeCallSite,
+		children: withPayload(Node{}, site),
+	})
+}
+
+// callSite returns the call site recorded by withCallSite or nil.
+func (n *Node) callSite() *callSite {
+	last := len(n.children) - 1
+	if last < 0 || n.children[last].nodeType != nodeTypeCallSite {
+		return nil
+	}
+	return n.children[last].payload().(*callSite)
+}
+
+// recordCallSite returns the call site of the first caller outside of the
+// library if strict mode is enabled.
+func recordCallSite() *callSite {
+	if !strict.Load() {
+		return nil
+	}
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		inLibrary := strings.HasPrefix(frame.Function, libraryPrefix) &&
+			!strings.Contains(frame.Function, ".Test")
+		if !inLibrary {
+			return &callSite{function: frame.Function, file: frame.File, line: frame.Line}
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// strictError creates an error for the node, including the node's call site
+// if one was recorded.
+func strictError(node *Node, format string, args ...any) error {
+	err := &StrictError{Message: fmt.Sprintf(format, args...)}
+	if site := node.callSite(); site != nil {
+		err.CallSite = site.String()
+	}
+	return err
+}
+
+// isStrictError returns true if the error was caused by strict mode.
+func isStrictError(err error) bool {
+	var strictErr *StrictError
+	return errors.As(err, &strictErr)
+}
+
+// checkStrict returns an error if the tag misuses the library.
+func checkStrict(name string, node *Node) error {
+	switch {
+	case node.nodeType == nodeTypeForeignTag:
+		if !foreignElements[name] {
+			return strictError(node, "unknown foreign tag <%s>", name)
+		}
+	case foreignElements[name] && !knownElements[name]:
+		return strictError(node, "<%s> is a foreign element, so it must be created by NewForeignTag", name)
+	case !knownElements[strings.ToLower(name)] && !strings.Contains(name, "-") && !strings.HasPrefix(name, "!"):
+		return strictError(node, "unknown tag <%s>", name)
+	}
+	checker := strictChildren{tag: name, node: node}
+	for i := range node.children {
+		if err := checker.check(&node.children[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// strictChildren checks the children of a tag. Children created by Combine
+// are checked as if they were passed to the tag directly.
+type strictChildren struct {
+	tag        string
+	node       *Node
+	hasContent bool
+}
+
+func (s *strictChildren) check(child *Node) error {
+	switch child.nodeType {
+	case nodeTypeEmpty, nodeTypeFlush, nodeTypeCallSite:
+		return nil
+	case nodeTypeInvalid:
+		return strictError(child, "%s", child.str1)
+	case nodeTypeAttr, nodeTypeBoolAttr:
+		if s.hasContent {
+			return strictError(child, "attribute %q appears after the content of <%s>", child.str1, s.tag)
+		}
+		return nil
+	case nodeTypeMany:
+		for i := range child.children {
+			if err := s.check(&child.children[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if s.node.nodeType == nodeTypeVoidTag {
+			return strictError(s.node, "<%s> is a void tag, so its children are not rendered", s.tag)
+		}
+		s.hasContent = true
+		return nil
+	}
+}
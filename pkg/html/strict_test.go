@@ -0,0 +1,117 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func renderStrict(node Node) error {
+	return node.RenderTo(&bytes.Buffer{}, Strict())
+}
+
+func TestStrict(t *testing.T) {
+	type testCase struct {
+		name string
+		node Node
+		err  string
+	}
+	tests := []testCase{
+		{
+			"attribute after content",
+			NewTag("span", Combine(
+				NewAttribute("id", "id-value"),
+				NewTag("div", InnerText("div content")),
+				NewAttribute("class", "class-value"),
+			)),
+			`html: strict: attribute "class" appears after the content of <span>`,
+		},
+		{
+			"void children",
+			NewVoidTag("col", NewAttribute("span", "2"), NewTag("div")),
+			"html: strict: <col> is a void tag, so its children are not rendered",
+		},
+		{
+			"invalid attribute",
+			NewTag("div", NewAttribute("escape<test", "")),
+			`html: strict: invalid attribute name "escape<test": '<' is not allowed in attribute names`,
+		},
+		{
+			"invalid tag",
+			NewTag("div onclick=alert(1)"),
+			`html: strict: invalid tag name "div onclick=alert(1)": tag names may only contain ASCII letters and digits`,
+		},
+		{
+			"unknown tag",
+			NewTag("section", NewTag("dvi")),
+			"html: strict: unknown tag <dvi>",
+		},
+		{
+			"unknown foreign tag",
+			NewTag("p", NewForeignTag("svg", NewForeignTag("cirlce"))),
+			"html: strict: unknown foreign tag <cirlce>",
+		},
+		{
+			"svg element created by NewTag",
+			NewTag("svg", NewTag("linearGradient")),
+			"html: strict: <linearGradient> is a foreign element, so it must be created by NewForeignTag",
+		},
+		{
+			"mathml element created by NewTag",
+			NewTag("math", NewTag("mfrac")),
+			"html: strict: <mfrac> is a foreign element, so it must be created by NewForeignTag",
+		},
+	}
+	for _, tc := range tests {
+		require.EqualError(t, renderStrict(tc.node), tc.err, tc.name)
+		// Without strict mode the mistakes are silently corrected.
+		require.NoError(t, tc.node.RenderTo(&bytes.Buffer{}), tc.name)
+	}
+}
+
+func TestStrictValid(t *testing.T) {
+	node := Document(
+		NewAttribute("lang", "en"),
+		NewTag("head", NewVoidTag("meta", NewAttribute("charset", "utf-8"))),
+		NewTag("body",
+			NewAttribute("data-page", "index"),
+			NewTag("my-element", NewAttribute("xlink:href", "#icon"), InnerText("custom")),
+			Flush(),
+			NewTag("div", Combine(NewAttribute("id", "a"), NewBoolAttribute("hidden")), InnerText("content")),
+			NewForeignTag("svg", NewAttribute("viewBox", "0 0 8 8"), NewForeignTag("title", InnerText("Dot")), NewForeignTag("circle")),
+			NewForeignTag("math", NewForeignTag("msup", NewForeignTag("mi", InnerText("x")), NewForeignTag("mn", InnerText("2")))),
+		),
+	)
+	require.NoError(t, renderStrict(node))
+}
+
+func TestSetStrict(t *testing.T) {
+	SetStrict(true)
+	node := NewTag("div", InnerText("content"), NewAttribute("id", "late"))
+	SetStrict(false)
+
+	err := renderStrict(node)
+	var strictErr *StrictError
+	require.True(t, errors.As(err, &strictErr))
+	require.Equal(t, `attribute "id" appears after the content of <div>`, strictErr.Message)
+	require.Contains(t, strictErr.CallSite, "html.TestSetStrict at ")
+	require.Contains(t, strictErr.CallSite, "strict_test.go:")
+
+	SetStrict(true)
+	defer SetStrict(false)
+	_, err = node.RenderContext(context.Background())
+	require.ErrorAs(t, err, &strictErr)
+	require.Panics(t, func() { _ = node.String() })
+}
+
+func TestStrictErrorBoundary(t *testing.T) {
+	node := ErrorBoundary(fallbackView, NewTag("dvi"))
+	require.EqualError(t, renderStrict(node), "html: strict: unknown tag <dvi>")
+
+	SetStrict(true)
+	defer SetStrict(false)
+	require.Panics(t, func() { _ = node.String() })
+}
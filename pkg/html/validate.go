@@ -0,0 +1,324 @@
+package html
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Violation describes a place where a Node tree breaks the rules of the HTML
+// standard.
+type Violation struct {
+	// Path locates the offending element, e.g. `html>body>div[2]>p`.
+	// Elements that share their name with a sibling are numbered starting
+	// from 1.
+	Path    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// Validate checks the node against the content models of the WHATWG HTML
+// standard and returns every violation it finds. Validate checks that:
+//   - phrasing elements like <p> and <span> only contain phrasing content
+//   - elements like <li>, <td>, and <option> appear inside of their permitted
+//     parents
+//   - elements like <ul>, <tr>, and <select> only contain their permitted
+//     children
+//   - void elements don't have children and are not rendered with a closing
+//     tag
+//   - interactive elements are not nested inside of <a> or <button>
+//   - required attributes, like alt on <img>, are present
+//   - every id is unique
+//   - HTML elements inside of <svg> or <math> are inside of an element that
+//     accepts HTML, like <foreignObject> or <mtext>, and SVG and MathML
+//     elements are not mixed
+//
+// Validate is not a complete implementation of the standard. It checks the
+// mistakes that are easy to make when composing views. Lazy nodes are
+// evaluated with context.Background(). Elements at the top level of the node
+// are not checked against their permitted parents, so components can be
+// validated in isolation.
+//
+// Example Usage:
+//
+//	for _, violation := range Validate(indexDocument(articles)) {
+//		t.Error(violation)
+//	}
+func Validate(node Node) []Violation {
+	v := validator{ids: map[string]*element{}}
+	for _, e := range newElementTree(node).elements {
+		v.validate(e)
+	}
+	return v.violations
+}
+
+type validator struct {
+	violations []Violation
+	// ids maps each id to the first element that uses it.
+	ids map[string]*element
+}
+
+func (v *validator) report(e *element, format string, args ...any) {
+	v.violations = append(v.violations, Violation{
+		Path:    e.path(),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validate(e *element) {
+	v.validateNamespace(e)
+	if e.node.nodeType == nodeTypeForeignTag {
+		// The content models of HTML don't apply to foreign content.
+		v.validateAttributes(e)
+		return
+	}
+	v.validateVoid(e)
+	v.validateParent(e)
+	v.validateChildren(e)
+	v.validatePhrasing(e)
+	v.validateInteractive(e)
+	v.validateAttributes(e)
+}
+
+// validateNamespace checks that the element is in the namespace the HTML
+// parser puts it in. The parser moves HTML elements like <div> and <p> out of
+// foreign content, and other elements become SVG or MathML elements with the
+// same name, so a misplaced element doesn't render where it was written.
+func (v *validator) validateNamespace(e *element) {
+	if e.parent.isRoot() {
+		return
+	}
+	parent := e.parent.pathName()
+	switch contentNamespace(e.parent) {
+	case "svg":
+		switch elementNamespace(e) {
+		case "html":
+			v.report(e, "<%s> is an HTML element, so it is not allowed in <%s> unless it is inside of %s",
+				e.pathName(), parent, describeTags(svgIntegrationPoints))
+		case "math":
+			v.report(e, "<%s> is a MathML element, so it is not allowed in <%s> unless it is inside of <math>",
+				e.pathName(), parent)
+		}
+	case "math":
+		switch elementNamespace(e) {
+		case "html":
+			v.report(e, "<%s> is an HTML element, so it is not allowed in <%s> unless it is inside of %s with an HTML encoding",
+				e.pathName(), parent, describeTags(mathMLIntegrationPoints))
+		case "svg":
+			if e.name != "svg" || e.parent.name != "annotation-xml" {
+				v.report(e, "<%s> is an SVG element, so it is not allowed in <%s> unless it is an <svg> inside of <annotation-xml>",
+					e.pathName(), parent)
+			}
+		}
+	}
+}
+
+// elementNamespace returns "html", "svg", or "math" for the namespace the
+// element belongs to. <svg> and <math> belong to their namespace even if
+// they were created with NewTag.
+func elementNamespace(e *element) string {
+	switch {
+	case e.name == "svg":
+		return "svg"
+	case e.name == "math":
+		return "math"
+	case e.node.nodeType != nodeTypeForeignTag:
+		return "html"
+	case mathMLElements[e.node.str1]:
+		return "math"
+	default:
+		return "svg"
+	}
+}
+
+// contentNamespace returns the namespace the HTML parser puts the children
+// of the element in: "html", "svg", or "math". The children of elements in
+// foreign content are in the same namespace as the element, unless the
+// element is an integration point that accepts HTML.
+func contentNamespace(e *element) string {
+	if e.isRoot() {
+		return "html"
+	}
+	namespace := elementNamespace(e)
+	switch {
+	case namespace == "svg" && contains(svgIntegrationPoints, e.pathName()):
+		return "html"
+	case namespace == "math" && e.name == "annotation-xml":
+		encoding, _ := e.attribute("encoding")
+		encoding = strings.ToLower(encoding)
+		if encoding == "text/html" || encoding == "application/xhtml+xml" {
+			return "html"
+		}
+	case namespace == "math" && contains(mathMLIntegrationPoints, e.name):
+		return "html"
+	}
+	return namespace
+}
+
+func (v *validator) validateVoid(e *element) {
+	if !voidElements[e.name] {
+		if e.node.IsVoid() {
+			v.report(e, "<%s> is not a void element and needs a closing tag", e.name)
+		}
+		return
+	}
+	if !e.node.IsVoid() {
+		v.report(e, "<%s> is a void element and must not have a closing tag", e.name)
+		return
+	}
+	var content contentCounter
+	e.node.VisitChildren(&content)
+	if content != 0 {
+		v.report(e, "<%s> is a void element, so its children are not rendered", e.name)
+	}
+}
+
+func (v *validator) validateParent(e *element) {
+	parents, ok := permittedParents[e.name]
+	if !ok || e.parent.isRoot() {
+		return
+	}
+	parent := e.parent.name
+	if (e.name == "dt" || e.name == "dd") && parent == "div" && !e.parent.parent.isRoot() {
+		// Groups of dt and dd elements may be wrapped in a div.
+		parent = e.parent.parent.name
+	}
+	for _, permitted := range parents {
+		if parent == permitted {
+			return
+		}
+	}
+	v.report(e, "<%s> must be a child of %s, not <%s>", e.name, describeTags(parents), e.parent.name)
+}
+
+func (v *validator) validateChildren(e *element) {
+	if textOnlyElements[e.name] {
+		for _, child := range e.children {
+			v.report(child, "<%s> is not allowed in <%s>, which can only contain text", child.name, e.name)
+		}
+		return
+	}
+
+	children, ok := permittedChildren[e.name]
+	if !ok {
+		return
+	}
+	if e.hasVisibleText {
+		v.report(e, "<%s> must not contain text", e.name)
+	}
+	for _, child := range e.children {
+		if _, ok := permittedParents[child.name]; ok {
+			// The child reports its own violation.
+			continue
+		}
+		if !contains(children, child.name) && !scriptSupportingElements[child.name] {
+			v.report(child, "<%s> is not allowed in <%s>, which only accepts %s",
+				child.name, e.name, describeTags(children))
+		}
+	}
+}
+
+func (v *validator) validatePhrasing(e *element) {
+	// Autonomous custom elements, like <my-widget>, are phrasing content.
+	if phrasingElements[e.name] || strings.Contains(e.name, "-") || e.parent.isRoot() {
+		return
+	}
+	parent := e.parent
+	for transparentElements[parent.name] && !parent.parent.isRoot() {
+		parent = parent.parent
+	}
+	if phrasingOnlyElements[parent.name] {
+		v.report(e, "<%s> is not allowed in <%s>, which only accepts phrasing content", e.name, parent.name)
+	}
+}
+
+func (v *validator) validateInteractive(e *element) {
+	if !isInteractive(e) {
+		return
+	}
+	for ancestor := e.parent; !ancestor.isRoot(); ancestor = ancestor.parent {
+		if ancestor.name == "a" || ancestor.name == "button" {
+			v.report(e, "<%s> is interactive, so it can't be inside of <%s>", e.name, ancestor.name)
+			return
+		}
+	}
+}
+
+func (v *validator) validateAttributes(e *element) {
+	for _, name := range requiredAttributes[e.name] {
+		if _, ok := e.attribute(name); !ok {
+			v.report(e, "<%s> is missing the required %s attribute", e.name, name)
+		}
+	}
+	if e.name == "area" {
+		if _, ok := e.attribute("href"); ok {
+			if _, ok := e.attribute("alt"); !ok {
+				v.report(e, "<area> with an href is missing the required alt attribute")
+			}
+		}
+	}
+
+	id, ok := e.attribute("id")
+	if !ok {
+		return
+	}
+	if id == "" || strings.ContainsAny(id, " \t\n\f\r") {
+		v.report(e, "id %q must not be empty or contain whitespace", id)
+	}
+	if first, ok := v.ids[id]; ok {
+		v.report(e, "id %q is already used by %s", id, first.path())
+		return
+	}
+	v.ids[id] = e
+}
+
+func isInteractive(e *element) bool {
+	switch e.name {
+	case "a":
+		_, ok := e.attribute("href")
+		return ok
+	case "audio", "video":
+		_, ok := e.attribute("controls")
+		return ok
+	case "input":
+		inputType, _ := e.attribute("type")
+		return !strings.EqualFold(inputType, "hidden")
+	case "button", "details", "embed", "iframe", "label", "select", "textarea":
+		return true
+	default:
+		return false
+	}
+}
+
+// contentCounter counts the tags and text visited.
+type contentCounter int
+
+func (c *contentCounter) Tag(name string, node *Node)     { *c++ }
+func (c *contentCounter) VoidTag(name string, node *Node) { *c++ }
+func (c *contentCounter) Content(content string)          { *c++ }
+
+func describeTags(names []string) string {
+	tags := make([]string, len(names))
+	for i, name := range names {
+		tags[i] = "<" + name + ">"
+	}
+	switch len(tags) {
+	case 1:
+		return tags[0]
+	case 2:
+		return tags[0] + " or " + tags[1]
+	default:
+		return strings.Join(tags[:len(tags)-1], ", ") + ", or " + tags[len(tags)-1]
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func violationStrings(node Node) []string {
+	var result []string
+	for _, violation := range Validate(node) {
+		result = append(result, violation.String())
+	}
+	return result
+}
+
+func TestValidateValidDocument(t *testing.T) {
+	node := Document(
+		NewAttribute("lang", "en"),
+		NewTag("head",
+			NewTag("title", InnerText("title")),
+			NewVoidTag("link", NewAttribute("rel", "stylesheet"), NewAttribute("href", "/style.css")),
+		),
+		NewTag("body",
+			NewTag("p", InnerText("hello "), NewTag("a", NewAttribute("href", "/"), NewTag("em", InnerText("world")))),
+			NewTag("ul", NewTag("li", NewTag("div", InnerText("flow content")))),
+			NewTag("dl", NewTag("div", NewTag("dt", InnerText("term")), NewTag("dd", InnerText("definition")))),
+			NewTag("table", NewTag("tbody", NewTag("tr", NewTag("td", InnerText("cell"))))),
+			NewVoidTag("img", NewAttribute("src", "/cat.png"), NewAttribute("alt", "a cat")),
+			NewTag("a", NewAttribute("href", "/"), NewTag("div", InnerText("block link"))),
+			NewTag("p", NewTag("my-widget", InnerText("custom element"))),
+		),
+	)
+	require.Empty(t, violationStrings(node))
+}
+
+func TestValidate(t *testing.T) {
+	type testCase struct {
+		name       string
+		node       Node
+		violations []string
+	}
+	tests := []testCase{
+		{
+			"phrasing",
+			NewTag("div", NewTag("div"), NewTag("div", NewTag("p", NewTag("div")))),
+			[]string{"div>div[2]>p>div: <div> is not allowed in <p>, which only accepts phrasing content"},
+		},
+		{
+			"transparent",
+			NewTag("p", NewTag("a", NewTag("ul"))),
+			[]string{"p>a>ul: <ul> is not allowed in <p>, which only accepts phrasing content"},
+		},
+		{
+			"parent",
+			NewTag("div", NewTag("li"), NewTag("dl", NewTag("section", NewTag("dt")))),
+			[]string{
+				"div>li: <li> must be a child of <ul>, <ol>, or <menu>, not <div>",
+				"div>dl>section: <section> is not allowed in <dl>, which only accepts <dt>, <dd>, or <div>",
+				"div>dl>section>dt: <dt> must be a child of <dl>, not <section>",
+			},
+		},
+		{
+			"children",
+			NewTag("ul", InnerText("text"), NewTag("li"), NewTag("span"), NewTag("template")),
+			[]string{
+				"ul: <ul> must not contain text",
+				"ul>span: <span> is not allowed in <ul>, which only accepts <li>",
+			},
+		},
+		{
+			"text only",
+			NewTag("select", NewTag("option", NewTag("b"))),
+			[]string{"select>option>b: <b> is not allowed in <option>, which can only contain text"},
+		},
+		{
+			"void",
+			NewTag("div",
+				NewVoidTag("img", NewAttribute("src", "/a.png"), NewAttribute("alt", "a"), NewTag("span")),
+				NewTag("br"),
+				NewVoidTag("div"),
+			),
+			[]string{
+				"div>img: <img> is a void element, so its children are not rendered",
+				"div>br: <br> is a void element and must not have a closing tag",
+				"div>div: <div> is not a void element and needs a closing tag",
+			},
+		},
+		{
+			"interactive",
+			NewTag("a",
+				NewAttribute("href", "/"),
+				NewTag("span", NewTag("button")),
+				NewVoidTag("input", NewAttribute("type", "hidden")),
+			),
+			[]string{"a>span>button: <button> is interactive, so it can't be inside of <a>"},
+		},
+		{
+			"required attributes",
+			NewTag("div",
+				NewVoidTag("img", NewAttribute("src", "/cat.png")),
+				NewTag("map", NewAttribute("name", "map"), NewVoidTag("area", NewAttribute("href", "/"))),
+			),
+			[]string{
+				"div>img: <img> is missing the required alt attribute",
+				"div>map>area: <area> with an href is missing the required alt attribute",
+			},
+		},
+		{
+			"ids",
+			NewTag("div",
+				NewTag("p", NewAttribute("id", "a")),
+				NewTag("p", NewAttribute("id", "a b")),
+				NewTag("p", NewTag("span", NewAttribute("id", "a"))),
+			),
+			[]string{
+				`div>p[2]: id "a b" must not be empty or contain whitespace`,
+				`div>p[3]>span: id "a" is already used by div>p[1]`,
+			},
+		},
+		{
+			"foreign content",
+			NewTag("p", NewForeignTag("svg",
+				NewForeignTag("title", InnerText("Logo")),
+				NewForeignTag("a", NewAttribute("href", "/"), NewForeignTag("rect", NewAttribute("id", "r"))),
+				NewForeignTag("foreignObject", NewTag("div", NewAttribute("id", "r"))),
+			)),
+			[]string{`p>svg>foreignObject>div: id "r" is already used by p>svg>a>rect`},
+		},
+		{
+			"html in svg",
+			NewTag("body", NewForeignTag("svg", NewTag("div"), NewForeignTag("g", NewTag("p", InnerText("text"))))),
+			[]string{
+				"body>svg>div: <div> is an HTML element, so it is not allowed in <svg> unless it is inside of <foreignObject>, <desc>, or <title>",
+				"body>svg>g>p: <p> is an HTML element, so it is not allowed in <g> unless it is inside of <foreignObject>, <desc>, or <title>",
+			},
+		},
+		{
+			"html in math",
+			NewForeignTag("math", NewTag("table"), NewForeignTag("mrow", NewTag("span"))),
+			[]string{
+				"math>table: <table> is an HTML element, so it is not allowed in <math> unless it is inside of <mi>, <mo>, <mn>, <ms>, <mtext>, or <annotation-xml> with an HTML encoding",
+				"math>mrow>span: <span> is an HTML element, so it is not allowed in <mrow> unless it is inside of <mi>, <mo>, <mn>, <ms>, <mtext>, or <annotation-xml> with an HTML encoding",
+			},
+		},
+		{
+			"mathml in svg",
+			NewForeignTag("svg", NewForeignTag("mi", InnerText("x")), NewForeignTag("math")),
+			[]string{
+				"svg>mi: <mi> is a MathML element, so it is not allowed in <svg> unless it is inside of <math>",
+				"svg>math: <math> is a MathML element, so it is not allowed in <svg> unless it is inside of <math>",
+			},
+		},
+		{
+			"svg in math",
+			NewForeignTag("math", NewForeignTag("mrow", NewForeignTag("svg")), NewForeignTag("circle")),
+			[]string{
+				"math>mrow>svg: <svg> is an SVG element, so it is not allowed in <mrow> unless it is an <svg> inside of <annotation-xml>",
+				"math>circle: <circle> is an SVG element, so it is not allowed in <math> unless it is an <svg> inside of <annotation-xml>",
+			},
+		},
+		{
+			"integration points",
+			NewTag("div",
+				NewForeignTag("svg",
+					NewForeignTag("foreignObject", NewTag("div", NewForeignTag("math", NewForeignTag("mi")))),
+					NewForeignTag("desc", NewTag("span")),
+					NewForeignTag("title", NewTag("em")),
+				),
+				NewForeignTag("math",
+					NewForeignTag("mi", NewTag("span")),
+					NewForeignTag("mtext", NewTag("b")),
+					NewForeignTag("semantics",
+						NewForeignTag("annotation-xml", NewAttribute("encoding", "text/html"), NewTag("div")),
+						NewForeignTag("annotation-xml", NewAttribute("encoding", "image/svg+xml"), NewForeignTag("svg")),
+						NewForeignTag("annotation-xml", NewAttribute("encoding", "application/mathml+xml"), NewTag("div")),
+					),
+				),
+			),
+			[]string{
+				"div>math>semantics>annotation-xml[3]>div: <div> is an HTML element, so it is not allowed in <annotation-xml> unless it is inside of <mi>, <mo>, <mn>, <ms>, <mtext>, or <annotation-xml> with an HTML encoding",
+			},
+		},
+		{
+			"fragment",
+			Combine(NewTag("li"), NewTag("td")),
+			nil,
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.violations, violationStrings(tc.node), tc.name)
+	}
+}
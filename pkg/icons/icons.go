@@ -0,0 +1,250 @@
+// Package icons builds an SVG sprite from a set of SVG icon files. Each icon
+// is defined once, as a <symbol> in the sprite, and every use of the icon is a
+// small <svg> element that references the symbol, so a page that shows the
+// same icon many times doesn't repeat its paths.
+//
+// Example Usage:
+//
+//	//go:embed icons/*.svg
+//	var iconFiles embed.FS
+//
+//	var icon = icons.MustLoad(iconFiles, "icons/*.svg")
+//
+//	tag.Body(
+//		icon.Sprite(),
+//		tag.Button(icon.Icon("close", icons.SizeInt(16), icons.Title("Close"))),
+//	)
+//
+// renders as
+// <body><svg width="0" height="0" style="position: absolute" aria-hidden="true"><symbol id="icon-close" viewBox="0 0 24 24">...</symbol>...</svg>
+// <button><svg viewBox="0 0 24 24" width="16" height="16" role="img"><title>Close</title><use href="#icon-close"/></svg></button></body>
+package icons
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/aria"
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/svg"
+)
+
+// Set is a collection of icons loaded by Load. A Set is immutable and may be
+// shared across goroutines.
+//
+// The icons returned by Icon reference symbols in the sprite, so every
+// document that shows an icon must render Sprite exactly once. The Set
+// doesn't track which documents it is rendered in: an icon in a document
+// without the sprite renders as nothing, and a second sprite repeats the ids
+// of its symbols. Put the sprite in the layout shared by every page and use
+// html.Validate in tests to catch a repeated sprite.
+type Set struct {
+	icons  map[string]icon
+	sprite html.Node
+}
+
+type icon struct {
+	viewBox string
+	// use references the icon's symbol in the sprite.
+	use html.Node
+}
+
+// Load parses the SVG files in fsys that match pattern, like "icons/*.svg".
+// The name of an icon is its file name without the .svg extension, and the id
+// of its symbol in the sprite is the name prefixed by "icon-". Every file must
+// have a `viewBox` or a `width` and `height`.
+//
+// Ids inside of a file, like the id of a gradient, are prefixed by the id of
+// the icon's symbol, so "fill" in close.svg becomes "icon-close-fill".
+// References to them, like url(#fill) and href="#fill", are updated to
+// match.
+//
+// The attributes of the root <svg> element, like `fill` and `stroke`, are
+// moved to the symbol, except for its size, position, `id`, `class`, and
+// `version`. Elements and attributes added by editors, like Inkscape
+// metadata, are dropped.
+func Load(fsys fs.FS, pattern string) (*Set, error) {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("icons: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("icons: no files match %q", pattern)
+	}
+	sort.Strings(files)
+
+	set := &Set{icons: map[string]icon{}}
+	// ids maps the ids in the sprite to the file that defines them.
+	ids := map[string]string{}
+	var symbols []html.Node
+	for _, file := range files {
+		name := strings.TrimSuffix(path.Base(file), ".svg")
+		if _, ok := set.icons[name]; ok {
+			return nil, fmt.Errorf("icons: %s: there is already an icon named %q", file, name)
+		}
+		if strings.ContainsAny(name, " \t\n\f\r") {
+			return nil, fmt.Errorf("icons: %s: icon names may not contain whitespace", file)
+		}
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("icons: %w", err)
+		}
+		id := "icon-" + name
+		attributes, children, err := parseSVG(data, id+"-")
+		if err != nil {
+			return nil, fmt.Errorf("icons: %s: %w", file, err)
+		}
+		for _, defined := range append(definedIDs(children), id) {
+			if other, ok := ids[defined]; ok {
+				return nil, fmt.Errorf("icons: %s: id %q is already used by %s", file, defined, other)
+			}
+			ids[defined] = file
+		}
+
+		var viewBox, width, height string
+		options := []html.Node{attr.Id(id)}
+		for _, attribute := range attributes {
+			key, ok := attributeName(attribute.Name)
+			switch {
+			case !ok:
+			case key == "viewBox":
+				viewBox = attribute.Value
+			case key == "width":
+				width = attribute.Value
+			case key == "height":
+				height = attribute.Value
+			case key == "id" || key == "class" || key == "x" || key == "y" || key == "version":
+			default:
+				options = append(options, html.NewAttribute(key, attribute.Value))
+			}
+		}
+		if viewBox == "" {
+			if width == "" || height == "" {
+				return nil, fmt.Errorf("icons: %s: the <svg> element has no viewBox", file)
+			}
+			viewBox = "0 0 " + width + " " + height
+		}
+		options = append(options, svg.ViewBox(viewBox))
+		symbols = append(symbols, svg.Symbol(append(options, children...)...))
+
+		set.icons[name] = icon{
+			viewBox: viewBox,
+			use:     svg.Use(svg.HRef("#" + id)),
+		}
+	}
+	// The sprite is hidden by giving it no size rather than display: none,
+	// because gradients, clip paths, and masks inside of an undisplayed
+	// element don't render where they are referenced.
+	set.sprite = svg.SVG(
+		svg.WidthInt(0), svg.HeightInt(0), attr.Style("position: absolute"),
+		aria.Hidden(true),
+		html.Combine(symbols...),
+	)
+	return set, nil
+}
+
+// definedIDs returns the ids defined by the nodes and their descendants.
+func definedIDs(nodes []html.Node) []string {
+	var ids []string
+	for _, node := range nodes {
+		if id, ok := node.Attribute("id"); ok {
+			ids = append(ids, id)
+		}
+		ids = append(ids, definedIDs(node.Children())...)
+	}
+	return ids
+}
+
+// MustLoad is like Load but panics if the icons can't be loaded. It
+// simplifies loading icons embedded in the binary into a global variable.
+func MustLoad(fsys fs.FS, pattern string) *Set {
+	set, err := Load(fsys, pattern)
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
+
+// Sprite returns the hidden <svg> element that defines every icon of the set.
+// Render it exactly once per document, usually at the start of <body>. The
+// sprite isn't added to the document automatically, and rendering it twice is
+// only reported by html.Validate, because the ids of its symbols repeat.
+func (s *Set) Sprite() html.Node {
+	return s.sprite
+}
+
+// Names returns the names of the icons in the set in sorted order.
+func (s *Set) Names() []string {
+	names := make([]string, 0, len(s.icons))
+	for name := range s.icons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Icon returns an <svg> element that shows the named icon by referencing its
+// symbol in the sprite. Icons are decorative and hidden from assistive
+// technologies unless they are given a Title. If the set has no icon with the
+// name, Icon returns a node that renders as nothing and is reported in strict
+// mode.
+func (s *Set) Icon(name string, options ...Option) html.Node {
+	icon, ok := s.icons[name]
+	if !ok {
+		return html.Invalid(fmt.Errorf("icons: unknown icon %q", name))
+	}
+	var o iconOptions
+	for _, option := range options {
+		option(&o)
+	}
+	label, title := aria.Hidden(true), html.Node{}
+	if o.title != "" {
+		label, title = aria.Role(aria.RoleImg), svg.Title(html.InnerText(o.title))
+	}
+	return svg.SVG(svg.ViewBox(icon.viewBox), o.width, o.height, o.class, label, title, icon.use)
+}
+
+// Option configures an icon returned by Set.Icon.
+type Option func(*iconOptions)
+
+type iconOptions struct {
+	width  html.Node
+	height html.Node
+	class  html.Node
+	title  string
+}
+
+// Size sets the width and height of the icon, like Size("1em"). Without a
+// size, the icon should be sized by CSS.
+func Size(size string) Option {
+	return func(o *iconOptions) {
+		o.width, o.height = svg.Width(size), svg.Height(size)
+	}
+}
+
+// SizeInt sets the width and height of the icon in pixels, like SizeInt(24).
+func SizeInt(size int) Option {
+	return func(o *iconOptions) {
+		o.width, o.height = svg.WidthInt(size), svg.HeightInt(size)
+	}
+}
+
+// Class sets the class of the icon's <svg> element.
+func Class(class string) Option {
+	return func(o *iconOptions) {
+		o.class = attr.Class(class)
+	}
+}
+
+// Title gives the icon an accessible name, which is also shown as a tooltip.
+// An icon with a title has the `img` role. Leave out the title of icons that
+// are next to text that describes them, like the label of a button.
+func Title(title string) Option {
+	return func(o *iconOptions) {
+		o.title = title
+	}
+}
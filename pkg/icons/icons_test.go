@@ -0,0 +1,151 @@
+package icons
+
+import (
+	"bytes"
+	"embed"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed testdata/*.svg
+var testFiles embed.FS
+
+func TestSprite(t *testing.T) {
+	set, err := Load(testFiles, "testdata/*.svg")
+	require.NoError(t, err)
+	require.Equal(t, []string{"close", "logo"}, set.Names())
+	require.Equal(t, ``+
+		`<svg width="0" height="0" style="position: absolute" aria-hidden="true">`+
+		`<symbol id="icon-close" fill="none" stroke="currentColor" stroke-width="2" viewBox="0 0 24 24">`+
+		`<line x1="18" y1="6" x2="6" y2="18"/>`+
+		`<line x1="6" y1="6" x2="18" y2="18"/>`+
+		`</symbol>`+
+		`<symbol id="icon-logo" viewBox="0 0 16 16">`+
+		`<defs><linearGradient id="icon-logo-logo-fill"><stop offset="0" stop-color="#f80"/></linearGradient></defs>`+
+		`<g><circle id="icon-logo-dot" cx="8" cy="8" r="8" fill="url(#icon-logo-logo-fill)"/><use xlink:href="#icon-logo-dot"/><text x="8" y="12">S &amp; Co</text></g>`+
+		`</symbol>`+
+		`</svg>`,
+		set.Sprite().String())
+}
+
+func TestIcon(t *testing.T) {
+	set := MustLoad(testFiles, "testdata/*.svg")
+	require.Equal(t,
+		`<svg viewBox="0 0 24 24" aria-hidden="true"><use href="#icon-close"/></svg>`,
+		set.Icon("close").String())
+	require.Equal(t,
+		`<svg viewBox="0 0 24 24" width="16" height="16" class="icon" role="img"><title>Close</title><use href="#icon-close"/></svg>`,
+		set.Icon("close", SizeInt(16), Class("icon"), Title("Close")).String())
+	require.Equal(t,
+		`<svg viewBox="0 0 16 16" width="1em" height="1em" aria-hidden="true"><use href="#icon-logo"/></svg>`,
+		set.Icon("logo", Size("1em")).String())
+
+	require.Equal(t, "", set.Icon("missing").String())
+	err := set.Icon("missing").RenderTo(&bytes.Buffer{}, html.Strict())
+	require.EqualError(t, err, `html: strict: icons: unknown icon "missing"`)
+
+	page := html.Document(tag.Body(
+		set.Sprite(),
+		tag.Button(set.Icon("close", Title("Close"))),
+		tag.P(set.Icon("logo"), html.InnerText("Sanity")),
+	))
+	require.NoError(t, page.RenderTo(&bytes.Buffer{}, html.Strict()))
+	require.Empty(t, html.Validate(page))
+}
+
+func TestSpriteRenderedTwice(t *testing.T) {
+	// A Set doesn't know which documents it is rendered in, so a sprite that
+	// is rendered twice is only detected by the duplicate ids of its
+	// symbols.
+	set := MustLoad(testFiles, "testdata/*.svg")
+	page := html.Document(tag.Body(
+		set.Sprite(),
+		tag.Main(set.Icon("close"), set.Sprite()),
+	))
+	require.Equal(t, []string{
+		`html>body>main>svg[2]>symbol[1]: id "icon-close" is already used by html>body>svg>symbol[1]`,
+		`html>body>main>svg[2]>symbol[2]: id "icon-logo" is already used by html>body>svg>symbol[2]`,
+		`html>body>main>svg[2]>symbol[2]>defs>linearGradient: id "icon-logo-logo-fill" is already used by html>body>svg>symbol[2]>defs>linearGradient`,
+		`html>body>main>svg[2]>symbol[2]>g>circle: id "icon-logo-dot" is already used by html>body>svg>symbol[2]>g>circle`,
+	}, violations(page))
+}
+
+func violations(node html.Node) []string {
+	var result []string
+	for _, violation := range html.Validate(node) {
+		result = append(result, violation.String())
+	}
+	return result
+}
+
+func TestInternalIDs(t *testing.T) {
+	// Both icons define a gradient with the same id. Each icon's references
+	// must keep pointing at its own gradient once they share a sprite.
+	gradient := func(color string) string {
+		return `<svg viewBox="0 0 8 8" fill="url(#g)">` +
+			`<style>.a { fill: url('#g') }</style>` +
+			`<linearGradient id="g"><stop stop-color="` + color + `"/></linearGradient>` +
+			`<rect id="r" width="8" height="8" style="fill: url(#g)" clip-path="url(#missing)"/>` +
+			`<use href="#r"/><a href="#top"/>` +
+			`</svg>`
+	}
+	files := fstest.MapFS{
+		"icons/red.svg":  {Data: []byte(gradient("red"))},
+		"icons/blue.svg": {Data: []byte(gradient("blue"))},
+	}
+	set, err := Load(files, "icons/*.svg")
+	require.NoError(t, err)
+	require.Equal(t, ``+
+		`<svg width="0" height="0" style="position: absolute" aria-hidden="true">`+
+		`<symbol id="icon-blue" fill="url(#icon-blue-g)" viewBox="0 0 8 8">`+
+		`<style>.a { fill: url(&#39;#icon-blue-g&#39;) }</style>`+
+		`<linearGradient id="icon-blue-g"><stop stop-color="blue"/></linearGradient>`+
+		`<rect id="icon-blue-r" width="8" height="8" style="fill: url(#icon-blue-g)" clip-path="url(#missing)"/>`+
+		`<use href="#icon-blue-r"/><a href="#top"/>`+
+		`</symbol>`+
+		`<symbol id="icon-red" fill="url(#icon-red-g)" viewBox="0 0 8 8">`+
+		`<style>.a { fill: url(&#39;#icon-red-g&#39;) }</style>`+
+		`<linearGradient id="icon-red-g"><stop stop-color="red"/></linearGradient>`+
+		`<rect id="icon-red-r" width="8" height="8" style="fill: url(#icon-red-g)" clip-path="url(#missing)"/>`+
+		`<use href="#icon-red-r"/><a href="#top"/>`+
+		`</symbol>`+
+		`</svg>`,
+		set.Sprite().String())
+	require.Empty(t, html.Validate(tag.Body(set.Sprite())))
+}
+
+func TestLoadErrors(t *testing.T) {
+	files := fstest.MapFS{
+		"root/icon.html":     {Data: []byte(`<div></div>`)},
+		"size/icon.svg":      {Data: []byte(`<svg><path d="M0 0h8"/></svg>`)},
+		"broken/icon.svg":    {Data: []byte(`<svg viewBox="0 0 8 8"><path></svg>`)},
+		"empty/icon.svg":     {Data: []byte(`<!-- nothing -->`)},
+		"duplicate/a/x.svg":  {Data: []byte(`<svg viewBox="0 0 8 8"/>`)},
+		"duplicate/b/x.svg":  {Data: []byte(`<svg viewBox="0 0 8 8"/>`)},
+		"whitespace/a b.svg": {Data: []byte(`<svg viewBox="0 0 8 8"/>`)},
+		"collision/a-b.svg":  {Data: []byte(`<svg viewBox="0 0 8 8"/>`)},
+		"collision/a.svg":    {Data: []byte(`<svg viewBox="0 0 8 8"><path id="b"/></svg>`)},
+	}
+	tests := []struct {
+		pattern string
+		err     string
+	}{
+		{"missing/*.svg", `icons: no files match "missing/*.svg"`},
+		{"root/*", `icons: root/icon.html: the root element is <div>, not <svg>`},
+		{"size/*.svg", `icons: size/icon.svg: the <svg> element has no viewBox`},
+		{"broken/*.svg", `icons: broken/icon.svg: XML syntax error on line 1: element <path> closed by </svg>`},
+		{"empty/*.svg", `icons: empty/icon.svg: missing <svg> element`},
+		{"duplicate/*/*.svg", `icons: duplicate/b/x.svg: there is already an icon named "x"`},
+		{"whitespace/*.svg", `icons: whitespace/a b.svg: icon names may not contain whitespace`},
+		{"collision/*.svg", `icons: collision/a.svg: id "icon-a-b" is already used by collision/a-b.svg`},
+	}
+	for _, tc := range tests {
+		_, err := Load(files, tc.pattern)
+		require.EqualError(t, err, tc.err, tc.pattern)
+	}
+	require.Panics(t, func() { MustLoad(files, "missing/*.svg") })
+}
@@ -0,0 +1,165 @@
+package icons
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/spec"
+)
+
+const xlinkNamespace = "http://www.w3.org/1999/xlink"
+
+// parseFrame is an element that is still open while parsing.
+type parseFrame struct {
+	name       string
+	attributes []xml.Attr
+	children   []html.Node
+	// skip is set for elements outside of the SVG namespace, like the
+	// metadata added by editors, which are dropped along with their
+	// children.
+	skip bool
+}
+
+// parseSVG parses an SVG file and returns the attributes and children of its
+// root <svg> element. Whitespace between elements, comments, and elements and
+// attributes outside of the SVG namespace are dropped. The ids defined in the
+// file and the references to them are prefixed, so the ids of different icons
+// in a sprite don't collide.
+func parseSVG(data []byte, prefix string) ([]xml.Attr, []html.Node, error) {
+	refs := references{prefix: prefix, ids: collectIDs(data)}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []parseFrame
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, nil, fmt.Errorf("missing <svg> element")
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			svgElement := token.Name.Space == "" || token.Name.Space == spec.SVGNamespace
+			if len(stack) == 0 && (!svgElement || token.Name.Local != "svg") {
+				return nil, nil, fmt.Errorf("the root element is <%s>, not <svg>", token.Name.Local)
+			}
+			for i := range token.Attr {
+				if name, ok := attributeName(token.Attr[i].Name); ok {
+					token.Attr[i].Value = refs.rewrite(name, token.Attr[i].Value)
+				}
+			}
+			stack = append(stack, parseFrame{
+				name:       token.Name.Local,
+				attributes: token.Attr,
+				skip:       !svgElement || (len(stack) != 0 && stack[len(stack)-1].skip),
+			})
+		case xml.EndElement:
+			frame := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return frame.attributes, frame.children, nil
+			}
+			if frame.skip {
+				continue
+			}
+			options := append(attributeNodes(frame.attributes), frame.children...)
+			parent := &stack[len(stack)-1]
+			parent.children = append(parent.children, html.NewForeignTag(frame.name, options...))
+		case xml.CharData:
+			if len(stack) == 0 || stack[len(stack)-1].skip || strings.TrimSpace(string(token)) == "" {
+				continue
+			}
+			parent := &stack[len(stack)-1]
+			text := string(token)
+			if parent.name == "style" {
+				text = refs.rewriteURLs(text)
+			}
+			parent.children = append(parent.children, html.InnerText(text))
+		}
+	}
+}
+
+// attributeNodes converts the attributes of an element into nodes. Namespace
+// declarations and attributes in foreign namespaces are dropped, except for
+// xlink attributes, like xlink:href, which are still used by old files.
+func attributeNodes(attributes []xml.Attr) []html.Node {
+	var nodes []html.Node
+	for _, attribute := range attributes {
+		if name, ok := attributeName(attribute.Name); ok {
+			nodes = append(nodes, html.NewAttribute(name, attribute.Value))
+		}
+	}
+	return nodes
+}
+
+func attributeName(name xml.Name) (string, bool) {
+	switch name.Space {
+	case "":
+		return name.Local, name.Local != "xmlns"
+	case xlinkNamespace, "xlink":
+		return "xlink:" + name.Local, true
+	}
+	return "", false
+}
+
+// urlReference matches a reference to an element in the same file, like
+// url(#gradient) in a `fill` attribute or a stylesheet.
+var urlReference = regexp.MustCompile(`url\(\s*(['"]?)#([^'")\s]+)(['"]?)\s*\)`)
+
+// references prefixes the ids defined in an icon file and the references to
+// them.
+type references struct {
+	prefix string
+	ids    map[string]bool
+}
+
+// rewrite returns the value of the attribute with its id or references
+// prefixed. References to ids that are not defined in the file are left
+// alone.
+func (r references) rewrite(name string, value string) string {
+	switch {
+	case name == "id" && r.ids[value]:
+		return r.prefix + value
+	case (name == "href" || name == "xlink:href") && strings.HasPrefix(value, "#") && r.ids[value[1:]]:
+		return "#" + r.prefix + value[1:]
+	}
+	return r.rewriteURLs(value)
+}
+
+func (r references) rewriteURLs(value string) string {
+	if !strings.Contains(value, "url(") {
+		return value
+	}
+	return urlReference.ReplaceAllStringFunc(value, func(reference string) string {
+		match := urlReference.FindStringSubmatch(reference)
+		if !r.ids[match[2]] {
+			return reference
+		}
+		return "url(" + match[1] + "#" + r.prefix + match[2] + match[3] + ")"
+	})
+}
+
+// collectIDs returns the ids defined in an SVG file. Syntax errors are
+// reported by parseSVG, so they are ignored here.
+func collectIDs(data []byte) map[string]bool {
+	ids := map[string]bool{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ids
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			for _, attribute := range start.Attr {
+				if attribute.Name.Space == "" && attribute.Name.Local == "id" {
+					ids[attribute.Value] = true
+				}
+			}
+		}
+	}
+}
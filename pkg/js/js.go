@@ -0,0 +1,110 @@
+// Package js builds JavaScript snippets for event handler attributes and
+// inline scripts. Values from Go are encoded as JavaScript literals, so they
+// can't break out of the snippet no matter what they contain.
+//
+// Example Usage:
+//
+//	event.OnClick(js.Call("toggle", id))
+//
+// renders as onclick="toggle(&#34;menu&#34;)" when id is "menu". The browser
+// decodes the attribute before running it, so the script is toggle("menu").
+package js
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Script is a JavaScript snippet. The zero value is an empty script.
+type Script struct {
+	code string
+	err  error
+}
+
+// This is the `this` keyword. In an event handler it is the element the
+// handler is attached to.
+var This = Raw("this")
+
+// Event is the event object that is passed to an event handler.
+var Event = Raw("event")
+
+// Raw returns a script containing code, which must be trusted. Raw does not
+// escape anything, so code must never contain user input. Use Call to pass
+// values to a function.
+//
+// Example Usage:
+// js.Raw("history.back()")
+func Raw(code string) Script {
+	return Script{code: code}
+}
+
+// Call returns a script that calls the function with the given arguments.
+// The function is a dotted path of identifiers, like "toggle" or
+// "app.menu.open". Arguments are encoded as JSON, which is valid JavaScript
+// and escapes characters like < and & that are special in HTML. Script
+// arguments, like This and Event, are inserted as code.
+//
+// If the function name is not a valid path or an argument can't be encoded
+// as JSON, the returned script has an error and renders as nothing. See
+// Script.Err.
+//
+// Example Usage:
+// js.Call("removeItem", js.This, 42, "</script>") is the script
+// removeItem(this,42,"\u003c/script\u003e")
+func Call(function string, args ...any) Script {
+	if !validPath(function) {
+		return Script{err: fmt.Errorf("js: invalid function name %q", function)}
+	}
+	code := function + "("
+	for i, arg := range args {
+		if i != 0 {
+			code += ","
+		}
+		if script, ok := arg.(Script); ok {
+			if script.err != nil {
+				return script
+			}
+			code += script.code
+			continue
+		}
+		encoded, err := json.Marshal(arg)
+		if err != nil {
+			return Script{err: fmt.Errorf("js: encoding argument %d of %s: %w", i, function, err)}
+		}
+		code += string(encoded)
+	}
+	return Script{code: code + ")"}
+}
+
+// String returns the script's code. It returns the empty string if the
+// script has an error.
+func (s Script) String() string {
+	if s.err != nil {
+		return ""
+	}
+	return s.code
+}
+
+// Err returns the error encountered while building the script, if any.
+func (s Script) Err() error {
+	return s.err
+}
+
+// validPath returns true if path is a dotted path of JavaScript identifiers.
+// Only ASCII identifiers are allowed.
+func validPath(path string) bool {
+	start := true
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '.' && !start:
+			start = true
+		case c == '_' || c == '$' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			start = false
+		case '0' <= c && c <= '9' && !start:
+		default:
+			return false
+		}
+	}
+	return !start
+}
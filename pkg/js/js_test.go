@@ -0,0 +1,47 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	type testCase struct {
+		script Script
+		code   string
+	}
+	tests := []testCase{
+		{Call("toggle"), `toggle()`},
+		{Call("toggle", "menu"), `toggle("menu")`},
+		{Call("app.cart.add", 42, 1.5, true, nil), `app.cart.add(42,1.5,true,null)`},
+		{Call("removeItem", This, Event), `removeItem(this,event)`},
+		{Call("show", map[string]int{"page": 2}), `show({"page":2})`},
+		{Call("_private$", []string{"a"}), `_private$(["a"])`},
+		// Quotes can't end the string, and characters that are special in
+		// HTML or that end a line in older JavaScript engines are escaped.
+		{Call("say", `"); alert(1); ("`), `say("\"); alert(1); (\"")`},
+		{Call("say", "</script>&\u2028"), `say("\u003c/script\u003e\u0026\u2028")`},
+		{Raw("history.back()"), `history.back()`},
+		{Script{}, ``},
+	}
+	for _, tc := range tests {
+		require.NoError(t, tc.script.Err())
+		require.Equal(t, tc.code, tc.script.String())
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	for _, name := range []string{"", "alert(1);f", "a..b", "a.", ".a", "1a", "a b", "a.1b"} {
+		script := Call(name)
+		require.EqualError(t, script.Err(), `js: invalid function name "`+name+`"`)
+		require.Equal(t, "", script.String())
+	}
+
+	script := Call("send", "ok", make(chan int))
+	require.EqualError(t, script.Err(), "js: encoding argument 1 of send: json: unsupported type: chan int")
+	require.Equal(t, "", script.String())
+
+	script = Call("outer", Call("bad name"))
+	require.EqualError(t, script.Err(), `js: invalid function name "bad name"`)
+}
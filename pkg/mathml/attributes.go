@@ -0,0 +1,232 @@
+// Code generated by internal/specgen from pkg/spec/mathml.json. DO NOT EDIT.
+
+package mathml
+
+import (
+	"github.com/jeffswenson/sanity/internal/attrvalue"
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// Accent constructs an html.Node for the `accent` attribute.
+//
+// Whether the overscript is an accent, which is drawn closer to the base,
+// either true or false.
+func Accent(value string) html.Node {
+	return html.NewAttribute("accent", value)
+}
+
+// AccentUnder constructs an html.Node for the `accentunder` attribute.
+//
+// Whether the underscript is an accent, which is drawn closer to the base,
+// either true or false.
+func AccentUnder(value string) html.Node {
+	return html.NewAttribute("accentunder", value)
+}
+
+// ColumnSpan constructs an html.Node for the `columnspan` attribute.
+//
+// Number of columns the cell spans.
+//
+// Use ColumnSpanInt to construct the attribute from an int.
+func ColumnSpan(value string) html.Node {
+	return html.NewAttribute("columnspan", value)
+}
+
+// ColumnSpanInt constructs the `columnspan` attribute from an int.
+func ColumnSpanInt(value int) html.Node {
+	return attrvalue.Int("columnspan", value)
+}
+
+// Depth constructs an html.Node for the `depth` attribute.
+//
+// Depth below the baseline, like 0.5em.
+func Depth(value string) html.Node {
+	return html.NewAttribute("depth", value)
+}
+
+// Dir constructs an html.Node for the `dir` attribute.
+//
+// Direction of the formula, either ltr or rtl.
+func Dir(value string) html.Node {
+	return html.NewAttribute("dir", value)
+}
+
+// Display constructs an html.Node for the `display` attribute.
+//
+// Whether the formula is a block or inline.
+func Display(value string) html.Node {
+	return html.NewAttribute("display", value)
+}
+
+// DisplayStyle constructs an html.Node for the `displaystyle` attribute.
+//
+// Whether the formula uses the larger display style, either true or false.
+func DisplayStyle(value string) html.Node {
+	return html.NewAttribute("displaystyle", value)
+}
+
+// Encoding constructs an html.Node for the `encoding` attribute.
+//
+// Format of the annotation, like application/x-tex.
+func Encoding(value string) html.Node {
+	return html.NewAttribute("encoding", value)
+}
+
+// Fence constructs an html.Node for the `fence` attribute.
+//
+// Whether the operator is a fence, like a parenthesis, either true or false.
+func Fence(value string) html.Node {
+	return html.NewAttribute("fence", value)
+}
+
+// Form constructs an html.Node for the `form` attribute.
+//
+// Position of the operator, either prefix, infix, or postfix.
+func Form(value string) html.Node {
+	return html.NewAttribute("form", value)
+}
+
+// Height constructs an html.Node for the `height` attribute.
+//
+// Height above the baseline, like 1em.
+func Height(value string) html.Node {
+	return html.NewAttribute("height", value)
+}
+
+// LargeOp constructs an html.Node for the `largeop` attribute.
+//
+// Whether the operator is drawn larger in display style, like a sum, either
+// true or false.
+func LargeOp(value string) html.Node {
+	return html.NewAttribute("largeop", value)
+}
+
+// LineThickness constructs an html.Node for the `linethickness` attribute.
+//
+// Thickness of the fraction bar, like 0 for a binomial coefficient.
+func LineThickness(value string) html.Node {
+	return html.NewAttribute("linethickness", value)
+}
+
+// LSpace constructs an html.Node for the `lspace` attribute.
+//
+// Space before the operator, or the offset of the contents of mpadded.
+func LSpace(value string) html.Node {
+	return html.NewAttribute("lspace", value)
+}
+
+// MathBackground constructs an html.Node for the `mathbackground` attribute.
+//
+// Background color.
+func MathBackground(value string) html.Node {
+	return html.NewAttribute("mathbackground", value)
+}
+
+// MathColor constructs an html.Node for the `mathcolor` attribute.
+//
+// Text color.
+func MathColor(value string) html.Node {
+	return html.NewAttribute("mathcolor", value)
+}
+
+// MathSize constructs an html.Node for the `mathsize` attribute.
+//
+// Font size.
+func MathSize(value string) html.Node {
+	return html.NewAttribute("mathsize", value)
+}
+
+// MathVariant constructs an html.Node for the `mathvariant` attribute.
+//
+// Style of a single character identifier, only normal is supported by MathML
+// Core.
+func MathVariant(value string) html.Node {
+	return html.NewAttribute("mathvariant", value)
+}
+
+// MaxSize constructs an html.Node for the `maxsize` attribute.
+//
+// Maximum size of a stretchy operator.
+func MaxSize(value string) html.Node {
+	return html.NewAttribute("maxsize", value)
+}
+
+// MinSize constructs an html.Node for the `minsize` attribute.
+//
+// Minimum size of a stretchy operator.
+func MinSize(value string) html.Node {
+	return html.NewAttribute("minsize", value)
+}
+
+// MovableLimits constructs an html.Node for the `movablelimits` attribute.
+//
+// Whether the scripts of the operator move to the side when it is not in
+// display style, either true or false.
+func MovableLimits(value string) html.Node {
+	return html.NewAttribute("movablelimits", value)
+}
+
+// RowSpan constructs an html.Node for the `rowspan` attribute.
+//
+// Number of rows the cell spans.
+//
+// Use RowSpanInt to construct the attribute from an int.
+func RowSpan(value string) html.Node {
+	return html.NewAttribute("rowspan", value)
+}
+
+// RowSpanInt constructs the `rowspan` attribute from an int.
+func RowSpanInt(value int) html.Node {
+	return attrvalue.Int("rowspan", value)
+}
+
+// RSpace constructs an html.Node for the `rspace` attribute.
+//
+// Space after the operator.
+func RSpace(value string) html.Node {
+	return html.NewAttribute("rspace", value)
+}
+
+// ScriptLevel constructs an html.Node for the `scriptlevel` attribute.
+//
+// Script level, which controls the font size, like 1 or +1.
+func ScriptLevel(value string) html.Node {
+	return html.NewAttribute("scriptlevel", value)
+}
+
+// Separator constructs an html.Node for the `separator` attribute.
+//
+// Whether the operator is a separator, like a comma, either true or false.
+func Separator(value string) html.Node {
+	return html.NewAttribute("separator", value)
+}
+
+// Stretchy constructs an html.Node for the `stretchy` attribute.
+//
+// Whether the operator stretches to the size of its neighbors, either true or
+// false.
+func Stretchy(value string) html.Node {
+	return html.NewAttribute("stretchy", value)
+}
+
+// Symmetric constructs an html.Node for the `symmetric` attribute.
+//
+// Whether a stretchy operator is symmetric around the math axis, either true or
+// false.
+func Symmetric(value string) html.Node {
+	return html.NewAttribute("symmetric", value)
+}
+
+// VOffset constructs an html.Node for the `voffset` attribute.
+//
+// Vertical offset of the contents of mpadded.
+func VOffset(value string) html.Node {
+	return html.NewAttribute("voffset", value)
+}
+
+// Width constructs an html.Node for the `width` attribute.
+//
+// Width, like 2em.
+func Width(value string) html.Node {
+	return html.NewAttribute("width", value)
+}
@@ -0,0 +1,216 @@
+// Code generated by internal/specgen from pkg/spec/mathml.json. DO NOT EDIT.
+
+package mathml
+
+import "github.com/jeffswenson/sanity/pkg/html"
+
+// Annotation constructs an html.Node for the `<annotation>` element.
+//
+// Annotation of a semantics element, in a text format like LaTeX.
+func Annotation(children ...html.Node) html.Node {
+	return html.NewForeignTag("annotation", children...)
+}
+
+// AnnotationXML constructs an html.Node for the `<annotation-xml>` element.
+//
+// Annotation of a semantics element, in an XML format like content MathML.
+func AnnotationXML(children ...html.Node) html.Node {
+	return html.NewForeignTag("annotation-xml", children...)
+}
+
+// MAction constructs an html.Node for the `<maction>` element.
+//
+// Legacy interactive element, which renders its first child.
+func MAction(children ...html.Node) html.Node {
+	return html.NewForeignTag("maction", children...)
+}
+
+// Math constructs an html.Node for the `<math>` element.
+//
+// MathML root.
+func Math(children ...html.Node) html.Node {
+	return html.NewForeignTag("math", children...)
+}
+
+// MError constructs an html.Node for the `<merror>` element.
+//
+// Error message, like a syntax error reported by a formula preprocessor.
+func MError(children ...html.Node) html.Node {
+	return html.NewForeignTag("merror", children...)
+}
+
+// MFrac constructs an html.Node for the `<mfrac>` element.
+//
+// Fraction, with the numerator as the first child and the denominator as the
+// second.
+func MFrac(children ...html.Node) html.Node {
+	return html.NewForeignTag("mfrac", children...)
+}
+
+// MI constructs an html.Node for the `<mi>` element.
+//
+// Identifier, like a variable or function name.
+func MI(children ...html.Node) html.Node {
+	return html.NewForeignTag("mi", children...)
+}
+
+// MMultiscripts constructs an html.Node for the `<mmultiscripts>` element.
+//
+// Base with prescripts and postscripts, like tensor indices.
+func MMultiscripts(children ...html.Node) html.Node {
+	return html.NewForeignTag("mmultiscripts", children...)
+}
+
+// MN constructs an html.Node for the `<mn>` element.
+//
+// Numeric literal.
+func MN(children ...html.Node) html.Node {
+	return html.NewForeignTag("mn", children...)
+}
+
+// MO constructs an html.Node for the `<mo>` element.
+//
+// Operator, fence, or separator, like +, (, or ,.
+func MO(children ...html.Node) html.Node {
+	return html.NewForeignTag("mo", children...)
+}
+
+// MOver constructs an html.Node for the `<mover>` element.
+//
+// Base with an overscript.
+func MOver(children ...html.Node) html.Node {
+	return html.NewForeignTag("mover", children...)
+}
+
+// MPadded constructs an html.Node for the `<mpadded>` element.
+//
+// Row with adjusted size and position.
+func MPadded(children ...html.Node) html.Node {
+	return html.NewForeignTag("mpadded", children...)
+}
+
+// MPhantom constructs an html.Node for the `<mphantom>` element.
+//
+// Row that takes up space but is invisible.
+func MPhantom(children ...html.Node) html.Node {
+	return html.NewForeignTag("mphantom", children...)
+}
+
+// MPrescripts constructs an html.Node for the `<mprescripts>` element.
+//
+// Separator between the postscripts and prescripts of mmultiscripts.
+func MPrescripts(children ...html.Node) html.Node {
+	return html.NewForeignTag("mprescripts", children...)
+}
+
+// MRoot constructs an html.Node for the `<mroot>` element.
+//
+// Root with an explicit index, like a cube root.
+func MRoot(children ...html.Node) html.Node {
+	return html.NewForeignTag("mroot", children...)
+}
+
+// MRow constructs an html.Node for the `<mrow>` element.
+//
+// Horizontal group of subexpressions.
+func MRow(children ...html.Node) html.Node {
+	return html.NewForeignTag("mrow", children...)
+}
+
+// MS constructs an html.Node for the `<ms>` element.
+//
+// String literal.
+func MS(children ...html.Node) html.Node {
+	return html.NewForeignTag("ms", children...)
+}
+
+// MSpace constructs an html.Node for the `<mspace>` element.
+//
+// Blank space of a given size.
+func MSpace(children ...html.Node) html.Node {
+	return html.NewForeignTag("mspace", children...)
+}
+
+// MSqrt constructs an html.Node for the `<msqrt>` element.
+//
+// Square root.
+func MSqrt(children ...html.Node) html.Node {
+	return html.NewForeignTag("msqrt", children...)
+}
+
+// MStyle constructs an html.Node for the `<mstyle>` element.
+//
+// Row that changes the style of its children.
+func MStyle(children ...html.Node) html.Node {
+	return html.NewForeignTag("mstyle", children...)
+}
+
+// MSub constructs an html.Node for the `<msub>` element.
+//
+// Base with a subscript.
+func MSub(children ...html.Node) html.Node {
+	return html.NewForeignTag("msub", children...)
+}
+
+// MSubSup constructs an html.Node for the `<msubsup>` element.
+//
+// Base with a subscript and a superscript.
+func MSubSup(children ...html.Node) html.Node {
+	return html.NewForeignTag("msubsup", children...)
+}
+
+// MSup constructs an html.Node for the `<msup>` element.
+//
+// Base with a superscript.
+func MSup(children ...html.Node) html.Node {
+	return html.NewForeignTag("msup", children...)
+}
+
+// MTable constructs an html.Node for the `<mtable>` element.
+//
+// Table or matrix.
+func MTable(children ...html.Node) html.Node {
+	return html.NewForeignTag("mtable", children...)
+}
+
+// MTd constructs an html.Node for the `<mtd>` element.
+//
+// Cell of a table or matrix.
+func MTd(children ...html.Node) html.Node {
+	return html.NewForeignTag("mtd", children...)
+}
+
+// MText constructs an html.Node for the `<mtext>` element.
+//
+// Text without mathematical meaning.
+func MText(children ...html.Node) html.Node {
+	return html.NewForeignTag("mtext", children...)
+}
+
+// MTr constructs an html.Node for the `<mtr>` element.
+//
+// Row of a table or matrix.
+func MTr(children ...html.Node) html.Node {
+	return html.NewForeignTag("mtr", children...)
+}
+
+// MUnder constructs an html.Node for the `<munder>` element.
+//
+// Base with an underscript.
+func MUnder(children ...html.Node) html.Node {
+	return html.NewForeignTag("munder", children...)
+}
+
+// MUnderOver constructs an html.Node for the `<munderover>` element.
+//
+// Base with an underscript and an overscript.
+func MUnderOver(children ...html.Node) html.Node {
+	return html.NewForeignTag("munderover", children...)
+}
+
+// Semantics constructs an html.Node for the `<semantics>` element.
+//
+// Formula with annotations, whose first child is rendered.
+func Semantics(children ...html.Node) html.Node {
+	return html.NewForeignTag("semantics", children...)
+}
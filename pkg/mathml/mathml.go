@@ -0,0 +1,20 @@
+// Package mathml contains constructors for the elements and attributes of
+// MathML Core, the subset of MathML that browsers implement. The constructors
+// are generated from pkg/spec/mathml.json.
+//
+// MathML elements are foreign elements, so they render as self-closing tags
+// when they have no content. Use pkg/attr for the attributes MathML shares
+// with HTML, like `id`, `class`, and `style`.
+//
+// Example Usage:
+//
+//	mathml.Math(mathml.Display("block"),
+//		mathml.MFrac(
+//			mathml.MRow(mathml.MI(html.InnerText("a")), mathml.MO(html.InnerText("+")), mathml.MN(html.InnerText("1"))),
+//			mathml.MSup(mathml.MI(html.InnerText("x")), mathml.MN(html.InnerText("2"))),
+//		),
+//	)
+//
+// renders as
+// <math display="block"><mfrac><mrow><mi>a</mi><mo>+</mo><mn>1</mn></mrow><msup><mi>x</mi><mn>2</mn></msup></mfrac></math>
+package mathml
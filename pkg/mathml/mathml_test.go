@@ -0,0 +1,125 @@
+package mathml
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/jeffswenson/sanity/pkg/svg"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	formula := Math(Display("block"), attr.Class("formula"),
+		MRow(
+			MI(html.InnerText("x")),
+			MO(html.InnerText("=")),
+			MFrac(
+				MRow(MO(html.InnerText("−")), MI(html.InnerText("b")), MO(html.InnerText("±")),
+					MSqrt(MSup(MI(html.InnerText("b")), MN(html.InnerText("2"))), MO(html.InnerText("−")), MN(html.InnerText("4")), MI(html.InnerText("a")), MI(html.InnerText("c")))),
+				MRow(MN(html.InnerText("2")), MI(html.InnerText("a"))),
+			),
+			MSpace(Width("1em")),
+			MTable(MTr(MTd(ColumnSpanInt(2), MText(html.InnerText("a < b"))))),
+		),
+	)
+	require.Equal(t, ``+
+		`<math display="block" class="formula"><mrow>`+
+		`<mi>x</mi><mo>=</mo>`+
+		`<mfrac>`+
+		`<mrow><mo>−</mo><mi>b</mi><mo>±</mo>`+
+		`<msqrt><msup><mi>b</mi><mn>2</mn></msup><mo>−</mo><mn>4</mn><mi>a</mi><mi>c</mi></msqrt></mrow>`+
+		`<mrow><mn>2</mn><mi>a</mi></mrow>`+
+		`</mfrac>`+
+		`<mspace width="1em"/>`+
+		`<mtable><mtr><mtd columnspan="2"><mtext>a &lt; b</mtext></mtd></mtr></mtable>`+
+		`</mrow></math>`,
+		formula.String())
+
+	var buffer bytes.Buffer
+	require.NoError(t, tag.P(formula).RenderTo(&buffer, html.Strict()))
+	require.Empty(t, html.Validate(tag.P(formula)))
+}
+
+func TestAnnotationXML(t *testing.T) {
+	formula := Math(Semantics(
+		MI(html.InnerText("x")),
+		AnnotationXML(Encoding("application/mathml-content+xml"),
+			html.RawInnerText("<ci>x</ci>"),
+		),
+		Annotation(Encoding("application/x-tex"), html.InnerText("x")),
+	))
+	require.Equal(t, ``+
+		`<math><semantics><mi>x</mi>`+
+		`<annotation-xml encoding="application/mathml-content+xml"><ci>x</ci></annotation-xml>`+
+		`<annotation encoding="application/x-tex">x</annotation>`+
+		`</semantics></math>`,
+		formula.String())
+
+	var buffer bytes.Buffer
+	require.NoError(t, tag.P(formula).RenderTo(&buffer, html.Strict()))
+	require.Empty(t, html.Validate(tag.P(formula)))
+}
+
+func TestValidateNesting(t *testing.T) {
+	require.Equal(t, []html.Violation{{
+		Path:    "p>math>table",
+		Message: "<table> is an HTML element, so it is not allowed in <math> unless it is inside of <mi>, <mo>, <mn>, <ms>, <mtext>, or <annotation-xml> with an HTML encoding",
+	}}, html.Validate(tag.P(Math(tag.Table()))))
+	require.Equal(t, []html.Violation{{
+		Path:    "svg>mi",
+		Message: "<mi> is a MathML element, so it is not allowed in <svg> unless it is inside of <math>",
+	}}, html.Validate(svg.SVG(MI())))
+	require.Empty(t, html.Validate(tag.P(Math(MText(tag.B(html.InnerText("text")))))))
+}
+
+// TestCatalogMatchesSpec reads the package's source to find the constructors
+// and checks them against the MathML registry in pkg/spec.
+func TestCatalogMatchesSpec(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", nil, 0)
+	require.NoError(t, err)
+
+	elements := map[string]bool{}
+	attributes := map[string]bool{}
+	for _, file := range packages["mathml"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			literal, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+			name, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			switch fun := call.Fun.(type) {
+			case *ast.SelectorExpr:
+				switch fun.Sel.Name {
+				case "NewForeignTag":
+					elements[name] = true
+				case "NewAttribute", "NewBoolAttribute":
+					attributes[name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	for _, element := range spec.MathMLElements() {
+		require.True(t, elements[element.Name], "missing constructor for <%s>", element.Name)
+	}
+	require.Len(t, elements, len(spec.MathMLElements()))
+	for _, attribute := range spec.MathMLAttributes() {
+		require.True(t, attributes[attribute.Name], "missing constructor for %q", attribute.Name)
+	}
+	require.Len(t, attributes, len(spec.MathMLAttributes()))
+}
@@ -0,0 +1,208 @@
+package sanitytest
+
+import (
+	"fmt"
+	stdhtml "html"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/spec"
+)
+
+// rawTextElements are the elements whose content is not parsed as HTML.
+var rawTextElements = map[string]bool{
+	"script": true, "style": true,
+}
+
+// parseHTML converts the HTML written in a test into a Node, so it can be
+// compared to the Node produced by a view. parseHTML only understands well
+// formed HTML. It does not implement the error recovery or implied tags of
+// the HTML standard, so every non-void element must be closed.
+func parseHTML(source string) (html.Node, error) {
+	p := htmlParser{input: source}
+	stack := []parseFrame{{}}
+	for !p.done() {
+		switch {
+		case strings.HasPrefix(p.rest(), "<!--"):
+			end := strings.Index(p.rest(), "-->")
+			if end == -1 {
+				return html.Node{}, p.errorf("unterminated comment")
+			}
+			p.pos += end + len("-->")
+		case strings.HasPrefix(p.rest(), "</"):
+			start := p.pos
+			p.pos += len("</")
+			name := strings.ToLower(p.parseName())
+			p.skipSpace()
+			if !p.consume(">") {
+				return html.Node{}, p.errorf("expected '>'")
+			}
+			top := len(stack) - 1
+			if top == 0 || stack[top].name != name {
+				p.pos = start
+				return html.Node{}, p.errorf("unexpected closing tag </%s>", name)
+			}
+			frame := stack[top]
+			stack = stack[:top]
+			stack[top-1].append(html.NewTag(frame.tag, frame.children...))
+		case strings.HasPrefix(p.rest(), "<"):
+			p.pos++
+			tag := p.parseName()
+			if tag == "" {
+				return html.Node{}, p.errorf("expected a tag name")
+			}
+			name := strings.ToLower(tag)
+			if name == "!doctype" {
+				// The parser only understands the HTML5 doctype.
+				p.skipSpace()
+				doctype := strings.ToLower(p.parseName())
+				p.skipSpace()
+				if doctype != "html" || !p.consume(">") {
+					return html.Node{}, p.errorf("expected <!DOCTYPE html>")
+				}
+				stack[len(stack)-1].append(html.Doctype())
+				continue
+			}
+			if err := html.ValidateTagName(tag); err != nil {
+				return html.Node{}, p.errorf("%s", strings.TrimPrefix(err.Error(), "html: "))
+			}
+			attributes, selfClosing, err := p.parseAttributes()
+			if err != nil {
+				return html.Node{}, err
+			}
+			switch {
+			case spec.IsVoid(name):
+				stack[len(stack)-1].append(html.NewVoidTag(tag, attributes...))
+			case selfClosing:
+				// Only foreign elements, like SVG elements, may be
+				// self-closing.
+				stack[len(stack)-1].append(html.NewForeignTag(tag, attributes...))
+			case rawTextElements[name]:
+				end := strings.Index(strings.ToLower(p.rest()), "</"+name)
+				if end == -1 {
+					return html.Node{}, p.errorf("<%s> is not closed", name)
+				}
+				children := append(attributes, html.RawInnerText(p.rest()[:end]))
+				p.pos += end
+				stack = append(stack, parseFrame{name: name, tag: tag, children: children})
+			default:
+				stack = append(stack, parseFrame{name: name, tag: tag, children: attributes})
+			}
+		default:
+			end := strings.IndexByte(p.rest(), '<')
+			if end == -1 {
+				end = len(p.rest())
+			}
+			stack[len(stack)-1].append(html.InnerText(stdhtml.UnescapeString(p.rest()[:end])))
+			p.pos += end
+		}
+	}
+	if len(stack) != 1 {
+		return html.Node{}, p.errorf("<%s> is not closed", stack[len(stack)-1].name)
+	}
+	return html.Combine(stack[0].children...), nil
+}
+
+type parseFrame struct {
+	// name is the lower case name used to match the closing tag. tag is the
+	// name as it was written.
+	name     string
+	tag      string
+	children []html.Node
+}
+
+func (f *parseFrame) append(node html.Node) {
+	f.children = append(f.children, node)
+}
+
+type htmlParser struct {
+	input string
+	pos   int
+}
+
+// parseAttributes parses the attributes of a start tag up to and including
+// the closing '>'.
+func (p *htmlParser) parseAttributes() (attributes []html.Node, selfClosing bool, err error) {
+	for {
+		p.skipSpace()
+		switch {
+		case p.done():
+			return nil, false, p.errorf("unterminated tag")
+		case p.consume(">"):
+			return attributes, false, nil
+		case p.consume("/>"):
+			return attributes, true, nil
+		}
+
+		name := p.parseName()
+		if name == "" {
+			return nil, false, p.errorf("expected an attribute name")
+		}
+		if err := html.ValidateAttributeName(name); err != nil {
+			return nil, false, p.errorf("%s", strings.TrimPrefix(err.Error(), "html: "))
+		}
+		p.skipSpace()
+		if !p.consume("=") {
+			attributes = append(attributes, html.NewBoolAttribute(name))
+			continue
+		}
+		p.skipSpace()
+		if p.done() {
+			return nil, false, p.errorf("expected an attribute value")
+		}
+
+		var value string
+		if quote := p.rest()[:1]; quote == `"` || quote == "'" {
+			end := strings.Index(p.rest()[1:], quote)
+			if end == -1 {
+				return nil, false, p.errorf("unterminated attribute value")
+			}
+			value = p.rest()[1 : end+1]
+			p.pos += end + 2
+		} else {
+			end := strings.IndexAny(p.rest(), " \t\n\r\f>")
+			if end == -1 {
+				return nil, false, p.errorf("unterminated tag")
+			}
+			value = p.rest()[:end]
+			p.pos += end
+		}
+		attributes = append(attributes, html.NewAttribute(name, stdhtml.UnescapeString(value)))
+	}
+}
+
+func (p *htmlParser) parseName() string {
+	end := strings.IndexAny(p.rest(), " \t\n\r\f/>=")
+	if end == -1 {
+		end = len(p.rest())
+	}
+	name := p.rest()[:end]
+	p.pos += end
+	return name
+}
+
+func (p *htmlParser) skipSpace() {
+	for !p.done() && strings.IndexByte(" \t\n\r\f", p.input[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+func (p *htmlParser) consume(prefix string) bool {
+	if strings.HasPrefix(p.rest(), prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (p *htmlParser) rest() string {
+	return p.input[p.pos:]
+}
+
+func (p *htmlParser) done() bool {
+	return len(p.input) <= p.pos
+}
+
+func (p *htmlParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("sanitytest: invalid html at offset %d: "+format, append([]any{p.pos}, args...)...)
+}
@@ -0,0 +1,124 @@
+// Package sanitytest contains assertions for testing Sanity views. The
+// assertions compare the structure of Node trees instead of rendered strings,
+// so failures point at the element that differs instead of printing two
+// long lines of HTML.
+package sanitytest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// TestingT is the subset of testing.TB used by the assertions. *testing.T
+// and *testing.B implement TestingT.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// maxDifferences limits the number of differences listed by AssertEqualHTML.
+const maxDifferences = 10
+
+// AssertEqualHTML asserts that the node renders the same HTML as the
+// expected string. Whitespace in text, the order of attributes, and the
+// order of classes in the class attribute are ignored. If the HTML differs,
+// the error lists the path to each differing element and shows a diff of the
+// subtree containing the first difference.
+//
+// The expected HTML must be well formed. Every element other than a void
+// element must be closed.
+//
+// Example Usage:
+//
+//	sanitytest.AssertEqualHTML(t, `
+//		<ul class="fruit-list">
+//			<li>apple</li>
+//			<li>orange</li>
+//		</ul>`,
+//		fruitView([]string{"apple", "orange"}),
+//	)
+func AssertEqualHTML(t TestingT, expected string, actual html.Node) bool {
+	t.Helper()
+	expectedNode, err := parseHTML(expected)
+	if err != nil {
+		t.Errorf("%s", err)
+		return false
+	}
+
+	differences := compare("", nil, nil, normalize(expectedNode), normalize(actual))
+	if len(differences) == 0 {
+		return true
+	}
+
+	var message strings.Builder
+	message.WriteString("HTML is not equal:\n")
+	for i, d := range differences {
+		if i == maxDifferences {
+			fmt.Fprintf(&message, "\t... and %d more\n", len(differences)-maxDifferences)
+			break
+		}
+		fmt.Fprintf(&message, "\t%s: %s\n", displayPath(d.path), d.message)
+	}
+	first := differences[0]
+	fmt.Fprintf(&message, "\nDiff of %s (-expected +actual):\n", displayPath(first.path))
+	message.WriteString(diffLines(format(first.expected), format(first.actual)))
+	t.Errorf("%s", message.String())
+	return false
+}
+
+// AssertHasText asserts that the text rendered by the node contains the
+// expected text. Whitespace is collapsed before comparing, so the assertion
+// works across element boundaries and formatting.
+//
+// Example Usage:
+//
+//	title, _ := html.Query(page, "h1")
+//	sanitytest.AssertHasText(t, title, "Sanity News")
+func AssertHasText(t TestingT, node html.Node, expected string) bool {
+	t.Helper()
+	text := collapseSpace(node.Text())
+	if !strings.Contains(text, collapseSpace(expected)) {
+		t.Errorf("%s does not contain text %q\n\ttext: %q", describe(node), expected, text)
+		return false
+	}
+	return true
+}
+
+// AssertAttr asserts that the node has an attribute with the expected value.
+// Use an expected value of "" for bool attributes.
+//
+// Example Usage:
+//
+//	link, _ := html.Query(page, "a.article-name")
+//	sanitytest.AssertAttr(t, link, "href", "/article/1")
+func AssertAttr(t TestingT, node html.Node, name string, expected string) bool {
+	t.Helper()
+	value, ok := node.Attribute(name)
+	if !ok {
+		t.Errorf("%s does not have attribute %s", describe(node), name)
+		return false
+	}
+	if value != expected {
+		t.Errorf("%s has attribute %s=%q, expected %q", describe(node), name, value, expected)
+		return false
+	}
+	return true
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// describe returns a short description of the node for error messages.
+func describe(node html.Node) string {
+	elements := normalize(node)
+	if len(elements) == 1 && elements[0].name != "" {
+		return elements[0].openTag()
+	}
+	return "node"
+}
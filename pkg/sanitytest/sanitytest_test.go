@@ -0,0 +1,161 @@
+package sanitytest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/svg"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingT records the errors reported by an assertion.
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func fruitView(fruits []string) html.Node {
+	return tag.UL(
+		attr.Class("fruit-list"),
+		attr.Id("fruit"),
+		html.ForEach(fruits, func(fruit string) html.Node {
+			return tag.LI(html.InnerText(fruit))
+		}),
+	)
+}
+
+func TestAssertEqualHTML(t *testing.T) {
+	AssertEqualHTML(t, `
+		<ul id="fruit" class="fruit-list">
+			<li>apple</li>
+			<li>
+				orange
+			</li>
+		</ul>`,
+		fruitView([]string{"apple", "orange"}),
+	)
+
+	AssertEqualHTML(t, `
+		<!DOCTYPE html>
+		<html lang="en">
+			<head><title>Fish &amp; Chips</title></head>
+			<body>
+				<input type=checkbox checked>
+				<script>if (a < b) {}</script>
+			</body>
+		</html>`,
+		html.Document(
+			attr.Lang("en"),
+			tag.Head(tag.Title(html.InnerText("Fish & Chips"))),
+			tag.Body(
+				tag.Input(attr.Checked(), attr.Type("checkbox")),
+				tag.Script(html.RawInnerText("if (a < b) {}")),
+			),
+		),
+	)
+}
+
+func TestAssertEqualHTMLForeign(t *testing.T) {
+	AssertEqualHTML(t, `
+		<svg viewBox="0 0 8 8">
+			<circle cx="4" cy="4" r="4"/>
+			<linearGradient id="g"><stop offset="1" /></linearGradient>
+		</svg>`,
+		svg.SVG(
+			svg.ViewBox("0 0 8 8"),
+			svg.Circle(svg.CXInt(4), svg.CYInt(4), svg.RInt(4)),
+			svg.LinearGradient(attr.Id("g"), svg.Stop(svg.Offset("1"))),
+		),
+	)
+}
+
+func TestAssertEqualHTMLText(t *testing.T) {
+	recorder := &recordingT{}
+	ok := AssertEqualHTML(recorder, `
+		<ul class="fruit-list" id="fruit">
+			<li>apple</li>
+			<li>orange</li>
+		</ul>`,
+		fruitView([]string{"apple", "banana"}),
+	)
+	require.False(t, ok)
+	require.Equal(t, []string{`HTML is not equal:
+	ul>li[2]>text(): expected text "orange", actual text "banana"
+
+Diff of ul>li[2]>text() (-expected +actual):
+- <li>orange</li>
++ <li>banana</li>
+`}, recorder.errors)
+}
+
+func TestAssertEqualHTMLStructure(t *testing.T) {
+	recorder := &recordingT{}
+	ok := AssertEqualHTML(recorder, `
+		<div>
+			<p class="a b">one</p>
+			<ul><li>apple</li><li>orange</li></ul>
+		</div>`,
+		tag.Div(
+			tag.P(attr.Class("b a"), html.InnerText("one")),
+			tag.UL(tag.LI(html.InnerText("apple"))),
+		),
+	)
+	require.False(t, ok)
+	require.Equal(t, []string{`HTML is not equal:
+	div>p: attribute class: expected "a b", actual "b a"
+	div>ul: expected children [li[1] li[2]], actual children [li]
+
+Diff of div>p (-expected +actual):
+- <p class="a b">one</p>
++ <p class="b a">one</p>
+`}, recorder.errors)
+}
+
+func TestAssertEqualHTMLAttributes(t *testing.T) {
+	recorder := &recordingT{}
+	AssertEqualHTML(recorder, `<input type="text" required>`, tag.Input(attr.Name("email")))
+	require.Len(t, recorder.errors, 1)
+	require.Contains(t, recorder.errors[0],
+		"input: unexpected attribute name; missing attribute required; missing attribute type")
+}
+
+func TestAssertEqualHTMLInvalid(t *testing.T) {
+	recorder := &recordingT{}
+	require.False(t, AssertEqualHTML(recorder, `<div><p></div>`, tag.Div()))
+	require.Equal(t, []string{"sanitytest: invalid html at offset 8: unexpected closing tag </div>"}, recorder.errors)
+
+	recorder = &recordingT{}
+	require.False(t, AssertEqualHTML(recorder, `<div a"b=c></div>`, tag.Div()))
+	require.Equal(t, []string{`sanitytest: invalid html at offset 8: invalid attribute name "a\"b": '"' is not allowed in attribute names`}, recorder.errors)
+}
+
+func TestAssertHasText(t *testing.T) {
+	node := tag.P(html.InnerText("fish "), tag.Em(html.InnerText("&")), html.InnerText("\n  chips"))
+	AssertHasText(t, node, "fish & chips")
+
+	recorder := &recordingT{}
+	require.False(t, AssertHasText(recorder, node, "salad"))
+	require.Equal(t, []string{"<p> does not contain text \"salad\"\n\ttext: \"fish & chips\""}, recorder.errors)
+}
+
+func TestAssertAttr(t *testing.T) {
+	node := tag.A(attr.HRef("/a?b=1&c=2"), attr.Hidden())
+	AssertAttr(t, node, "href", "/a?b=1&c=2")
+	AssertAttr(t, node, "hidden", "")
+
+	recorder := &recordingT{}
+	require.False(t, AssertAttr(recorder, node, "href", "/b"))
+	require.False(t, AssertAttr(recorder, node, "title", "link"))
+	require.Equal(t, []string{
+		`<a hidden href="/a?b=1&amp;c=2"> has attribute href="/a?b=1&c=2", expected "/b"`,
+		`<a hidden href="/a?b=1&amp;c=2"> does not have attribute title`,
+	}, recorder.errors)
+}
@@ -0,0 +1,100 @@
+package sanitytest
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// update is prefixed with the package name, so it doesn't clash with the
+// -update flag of the test package or of another golden file library.
+var update = flag.Bool("sanitytest.update", false, "update sanitytest golden files")
+
+// Scrubber rewrites volatile content, like timestamps or generated ids, in
+// the pretty printed HTML before it is compared to the golden file.
+type Scrubber func(formatted string) string
+
+// ScrubRegexp replaces every match of the regular expression with the
+// replacement. The replacement may refer to submatches using the syntax of
+// regexp.Regexp.ReplaceAllString.
+//
+// Example Usage:
+//
+//	timestamps := sanitytest.ScrubRegexp(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`, "<timestamp>")
+func ScrubRegexp(pattern string, replacement string) Scrubber {
+	re := regexp.MustCompile(pattern)
+	return func(formatted string) string {
+		return re.ReplaceAllString(formatted, replacement)
+	}
+}
+
+// ScrubAttribute replaces the value of every attribute with the name.
+//
+// Example Usage:
+//
+//	ids := sanitytest.ScrubAttribute("id", "<id>")
+func ScrubAttribute(name string, replacement string) Scrubber {
+	return ScrubRegexp(`(\s`+regexp.QuoteMeta(name)+`=")[^"]*(")`, "${1}"+replacement+"${2}")
+}
+
+// Snapshot asserts that the node matches the golden file
+// `testdata/<name>.golden`. The golden file contains the pretty printed HTML
+// of the node after the scrubbers are applied. Run the tests with the
+// -sanitytest.update flag to create or update golden files:
+//
+//	go test ./... -sanitytest.update
+//
+// Example Usage:
+//
+//	func TestIndexDocument(t *testing.T) {
+//		page := indexDocument(generateArticles(3))
+//		sanitytest.Snapshot(t, "index", page,
+//			sanitytest.ScrubRegexp(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`, "<timestamp>"),
+//		)
+//	}
+func Snapshot(t TestingT, name string, node html.Node, scrubbers ...Scrubber) bool {
+	t.Helper()
+	return snapshot(t, "testdata", name, node, *update, scrubbers)
+}
+
+func snapshot(t TestingT, dir string, name string, node html.Node, update bool, scrubbers []Scrubber) bool {
+	t.Helper()
+	actual := format(normalize(node))
+	for _, scrub := range scrubbers {
+		actual = scrub(actual)
+	}
+
+	path := filepath.Join(dir, filepath.FromSlash(name)+".golden")
+	if update {
+		err := os.MkdirAll(filepath.Dir(path), 0o755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(actual), 0o644)
+		}
+		if err != nil {
+			t.Errorf("sanitytest: unable to update golden file: %s", err)
+			return false
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("sanitytest: golden file %s does not exist, run the test with -sanitytest.update to create it", path)
+		return false
+	}
+	if err != nil {
+		t.Errorf("sanitytest: unable to read golden file: %s", err)
+		return false
+	}
+	if string(expected) != actual {
+		t.Errorf("snapshot %s does not match %s, run the test with -sanitytest.update to accept the changes\n"+
+			"Diff (-expected +actual):\n%s", name, path, diffLines(string(expected), actual))
+		return false
+	}
+	return true
+}
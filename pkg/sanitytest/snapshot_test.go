@@ -0,0 +1,74 @@
+package sanitytest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func articleDocument(postedAt string) html.Node {
+	return html.Document(
+		attr.Lang("en"),
+		tag.Head(tag.Title(html.InnerText("Sanity News"))),
+		tag.Body(
+			tag.Nav(attr.Class("navigation"), html.InnerText("Sanity News")),
+			tag.Div(
+				attr.Class("article"),
+				attr.Id("article-"+postedAt),
+				tag.A(attr.HRef("/article/1"), html.InnerText("article title 1")),
+				tag.Span(html.InnerText("posted at "+postedAt)),
+			),
+		),
+	)
+}
+
+var timestamps = ScrubRegexp(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`, "<timestamp>")
+
+func TestSnapshot(t *testing.T) {
+	Snapshot(t, "article", articleDocument("2023-07-01 12:30:00"), timestamps, ScrubAttribute("id", "<id>"))
+}
+
+func TestSnapshotFlag(t *testing.T) {
+	// Test packages that import sanitytest may define their own -update flag.
+	require.Nil(t, flag.Lookup("update"))
+	require.NotNil(t, flag.Lookup("sanitytest.update"))
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	dir := t.TempDir()
+	recorder := &recordingT{}
+
+	require.False(t, snapshot(recorder, dir, "pages/index", articleDocument("2023-07-01 12:30:00"), false, nil))
+	require.Len(t, recorder.errors, 1)
+	require.Contains(t, recorder.errors[0], "does not exist, run the test with -sanitytest.update to create it")
+
+	require.True(t, snapshot(t, dir, "pages/index", articleDocument("2023-07-01 12:30:00"), true, []Scrubber{timestamps}))
+	golden, err := os.ReadFile(filepath.Join(dir, "pages", "index.golden"))
+	require.NoError(t, err)
+	require.Contains(t, string(golden), "    <span>posted at <timestamp></span>\n")
+
+	// A different timestamp is scrubbed, so the snapshot still matches.
+	require.True(t, snapshot(t, dir, "pages/index", articleDocument("2024-01-02 03:04:05"), false, []Scrubber{timestamps}))
+}
+
+func TestSnapshotMismatch(t *testing.T) {
+	dir := t.TempDir()
+	require.True(t, snapshot(t, dir, "index", tag.UL(tag.LI(html.InnerText("apple"))), true, nil))
+
+	recorder := &recordingT{}
+	require.False(t, snapshot(recorder, dir, "index", tag.UL(tag.LI(html.InnerText("orange"))), false, nil))
+	require.Equal(t, []string{"snapshot index does not match " + filepath.Join(dir, "index.golden") +
+		", run the test with -sanitytest.update to accept the changes\n" +
+		"Diff (-expected +actual):\n" +
+		"  <ul>\n" +
+		"-   <li>apple</li>\n" +
+		"+   <li>orange</li>\n" +
+		"  </ul>\n",
+	}, recorder.errors)
+}
@@ -0,0 +1,279 @@
+package sanitytest
+
+import (
+	stdhtml "html"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jeffswenson/sanity/internal/elementpath"
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// element is a normalized copy of a Node tree. Whitespace in text is
+// collapsed and attributes are sorted by name, so two trees that render the
+// same page compare as equal regardless of formatting or attribute order.
+type element struct {
+	// name is empty for text nodes.
+	name       string
+	void       bool
+	text       string
+	attributes []html.Attribute
+	children   []*element
+}
+
+// normalize converts the top level nodes of the node into elements.
+func normalize(node html.Node) []*element {
+	return normalizeChildren(html.Combine(node).Children())
+}
+
+func normalizeChildren(nodes []html.Node) []*element {
+	var result []*element
+	var text strings.Builder
+	flushText := func() {
+		if collapsed := collapseSpace(text.String()); collapsed != "" {
+			result = append(result, &element{text: collapsed})
+		}
+		text.Reset()
+	}
+	for _, node := range nodes {
+		if node.IsText() {
+			// Adjacent text nodes render as a single text node.
+			text.WriteString(node.Text())
+			continue
+		}
+		flushText()
+
+		attributes := node.Attributes()
+		for i := range attributes {
+			attributes[i].Name = strings.ToLower(attributes[i].Name)
+			if attributes[i].Name == "class" {
+				attributes[i].Value = collapseSpace(attributes[i].Value)
+			}
+		}
+		sort.SliceStable(attributes, func(i, j int) bool {
+			return attributes[i].Name < attributes[j].Name
+		})
+		result = append(result, &element{
+			name:       strings.ToLower(node.TagName()),
+			void:       node.IsVoid(),
+			attributes: attributes,
+			children:   normalizeChildren(node.Children()),
+		})
+	}
+	flushText()
+	return result
+}
+
+func collapseSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// segment returns the path segment used to identify the child in error
+// messages. Children that share their name with a sibling are numbered, e.g.
+// `div[2]`.
+func segment(siblings []*element, child int) string {
+	names := make([]string, len(siblings))
+	for i, sibling := range siblings {
+		names[i] = sibling.name
+		if names[i] == "" {
+			names[i] = "text()"
+		}
+	}
+	return elementpath.Segment(names, child)
+}
+
+// format pretty prints elements with one element or text node per line.
+// Elements that only contain text are printed on a single line.
+func format(elements []*element) string {
+	var builder strings.Builder
+	for _, e := range elements {
+		formatElement(&builder, e, 0)
+	}
+	return builder.String()
+}
+
+func formatElement(builder *strings.Builder, e *element, depth int) {
+	builder.WriteString(strings.Repeat("  ", depth))
+	if e.name == "" {
+		builder.WriteString(stdhtml.EscapeString(e.text))
+		builder.WriteString("\n")
+		return
+	}
+
+	builder.WriteString(e.openTag())
+	if e.void {
+		builder.WriteString("\n")
+		return
+	}
+	if len(e.children) == 1 && e.children[0].name == "" {
+		builder.WriteString(stdhtml.EscapeString(e.children[0].text))
+	} else if len(e.children) != 0 {
+		builder.WriteString("\n")
+		for _, child := range e.children {
+			formatElement(builder, child, depth+1)
+		}
+		builder.WriteString(strings.Repeat("  ", depth))
+	}
+	builder.WriteString("</" + e.name + ">\n")
+}
+
+func (e *element) openTag() string {
+	var builder strings.Builder
+	builder.WriteString("<" + e.name)
+	for _, attribute := range e.attributes {
+		builder.WriteString(" " + attribute.Name)
+		if !attribute.IsBool {
+			builder.WriteString(`="` + stdhtml.EscapeString(attribute.Value) + `"`)
+		}
+	}
+	builder.WriteString(">")
+	return builder.String()
+}
+
+// difference describes one way two element trees differ.
+type difference struct {
+	path    string
+	message string
+	// expected and actual are the subtrees that contain the difference.
+	expected []*element
+	actual   []*element
+}
+
+// compare returns the differences between two lists of sibling elements. The
+// parents are the elements containing the lists and are nil for the top level
+// of the tree. compare stops descending into a subtree once a difference is
+// found, because the differences inside of it are usually a consequence of
+// the first one.
+func compare(path string, expectedParent, actualParent *element, expected, actual []*element) []difference {
+	context := func() ([]*element, []*element) {
+		if expectedParent == nil {
+			return expected, actual
+		}
+		return []*element{expectedParent}, []*element{actualParent}
+	}
+
+	if len(expected) != len(actual) || !sameShape(expected, actual) {
+		d := difference{path: path, message: describeChildren(expected, actual)}
+		d.expected, d.actual = context()
+		return []difference{d}
+	}
+
+	var differences []difference
+	for i := range expected {
+		childPath := elementpath.Join(path, segment(expected, i))
+		e, a := expected[i], actual[i]
+		if e.name == "" {
+			if e.text != a.text {
+				d := difference{
+					path:    childPath,
+					message: "expected text " + strconv.Quote(e.text) + ", actual text " + strconv.Quote(a.text),
+				}
+				d.expected, d.actual = context()
+				differences = append(differences, d)
+			}
+			continue
+		}
+		if message := compareAttributes(e.attributes, a.attributes); message != "" {
+			differences = append(differences, difference{
+				path:     childPath,
+				message:  message,
+				expected: []*element{e},
+				actual:   []*element{a},
+			})
+			continue
+		}
+		differences = append(differences, compare(childPath, e, a, e.children, a.children)...)
+	}
+	return differences
+}
+
+// sameShape returns true if both lists contain the same sequence of element
+// names.
+func sameShape(expected []*element, actual []*element) bool {
+	for i := range expected {
+		if expected[i].name != actual[i].name {
+			return false
+		}
+	}
+	return true
+}
+
+func describeChildren(expected []*element, actual []*element) string {
+	describe := func(elements []*element) string {
+		names := make([]string, len(elements))
+		for i := range elements {
+			names[i] = segment(elements, i)
+		}
+		return "[" + strings.Join(names, " ") + "]"
+	}
+	return "expected children " + describe(expected) + ", actual children " + describe(actual)
+}
+
+func compareAttributes(expected []html.Attribute, actual []html.Attribute) string {
+	var messages []string
+	e, a := 0, 0
+	for e < len(expected) || a < len(actual) {
+		switch {
+		case a == len(actual) || e < len(expected) && expected[e].Name < actual[a].Name:
+			messages = append(messages, "missing attribute "+expected[e].Name)
+			e++
+		case e == len(expected) || actual[a].Name < expected[e].Name:
+			messages = append(messages, "unexpected attribute "+actual[a].Name)
+			a++
+		default:
+			if expected[e] != actual[a] {
+				messages = append(messages, "attribute "+expected[e].Name+
+					": expected "+strconv.Quote(expected[e].Value)+
+					", actual "+strconv.Quote(actual[a].Value))
+			}
+			e++
+			a++
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// diffLines returns a line diff of the two strings. Lines only in expected
+// are prefixed with "-", lines only in actual are prefixed with "+", and
+// common lines are prefixed with " ".
+func diffLines(expected string, actual string) string {
+	a := strings.Split(strings.TrimSuffix(expected, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(actual, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var builder strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			builder.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			builder.WriteString("- " + a[i] + "\n")
+			i++
+		default:
+			builder.WriteString("+ " + b[j] + "\n")
+			j++
+		}
+	}
+	return builder.String()
+}
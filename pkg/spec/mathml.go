@@ -0,0 +1,48 @@
+package spec
+
+// MathMLNamespace is the namespace of MathML elements.
+const MathMLNamespace = "http://www.w3.org/1998/Math/MathML"
+
+var (
+	mathMLElementIndex   = map[string]int{}
+	mathMLAttributeIndex = map[string]int{}
+)
+
+func init() {
+	for i := range mathmlElements {
+		mathMLElementIndex[mathmlElements[i].Name] = i
+	}
+	for i := range mathmlAttributes {
+		mathMLAttributeIndex[mathmlAttributes[i].Name] = i
+	}
+}
+
+// MathMLElements returns every element in MathML Core sorted by name. MathML
+// Core is the subset of MathML that browsers implement.
+func MathMLElements() []Element {
+	return append([]Element(nil), mathmlElements...)
+}
+
+// MathMLAttributes returns every MathML attribute in the registry sorted by
+// name.
+func MathMLAttributes() []Attribute {
+	return append([]Attribute(nil), mathmlAttributes...)
+}
+
+// LookupMathMLElement returns the MathML element with the given name.
+func LookupMathMLElement(name string) (Element, bool) {
+	i, ok := mathMLElementIndex[name]
+	if !ok {
+		return Element{}, false
+	}
+	return mathmlElements[i], true
+}
+
+// LookupMathMLAttribute returns the MathML attribute with the given name.
+func LookupMathMLAttribute(name string) (Attribute, bool) {
+	i, ok := mathMLAttributeIndex[name]
+	if !ok {
+		return Attribute{}, false
+	}
+	return mathmlAttributes[i], true
+}
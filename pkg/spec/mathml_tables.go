@@ -0,0 +1,346 @@
+// Code generated by internal/specgen from mathml.json. DO NOT EDIT.
+
+package spec
+
+var mathmlElements = []Element{
+	{
+		Name:        "annotation",
+		Description: "Annotation of a semantics element, in a text format like LaTeX",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"encoding"},
+	},
+	{
+		Name:        "annotation-xml",
+		Description: "Annotation of a semantics element, in an XML format like content MathML",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"encoding"},
+	},
+	{
+		Name:        "maction",
+		Description: "Legacy interactive element, which renders its first child",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "math",
+		Description: "MathML root",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"display"},
+	},
+	{
+		Name:        "merror",
+		Description: "Error message, like a syntax error reported by a formula preprocessor",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mfrac",
+		Description: "Fraction, with the numerator as the first child and the denominator as the second",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"linethickness"},
+	},
+	{
+		Name:        "mi",
+		Description: "Identifier, like a variable or function name",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"mathvariant"},
+	},
+	{
+		Name:        "mmultiscripts",
+		Description: "Base with prescripts and postscripts, like tensor indices",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mn",
+		Description: "Numeric literal",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mo",
+		Description: "Operator, fence, or separator, like +, (, or ,",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"fence", "form", "largeop", "lspace", "maxsize", "minsize", "movablelimits", "rspace", "separator", "stretchy", "symmetric"},
+	},
+	{
+		Name:        "mover",
+		Description: "Base with an overscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"accent"},
+	},
+	{
+		Name:        "mpadded",
+		Description: "Row with adjusted size and position",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"depth", "height", "lspace", "voffset", "width"},
+	},
+	{
+		Name:        "mphantom",
+		Description: "Row that takes up space but is invisible",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mprescripts",
+		Description: "Separator between the postscripts and prescripts of mmultiscripts",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mroot",
+		Description: "Root with an explicit index, like a cube root",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mrow",
+		Description: "Horizontal group of subexpressions",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "ms",
+		Description: "String literal",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mspace",
+		Description: "Blank space of a given size",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"depth", "height", "width"},
+	},
+	{
+		Name:        "msqrt",
+		Description: "Square root",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mstyle",
+		Description: "Row that changes the style of its children",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "msub",
+		Description: "Base with a subscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "msubsup",
+		Description: "Base with a subscript and a superscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "msup",
+		Description: "Base with a superscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mtable",
+		Description: "Table or matrix",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mtd",
+		Description: "Cell of a table or matrix",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"columnspan", "rowspan"},
+	},
+	{
+		Name:        "mtext",
+		Description: "Text without mathematical meaning",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "mtr",
+		Description: "Row of a table or matrix",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "munder",
+		Description: "Base with an underscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"accentunder"},
+	},
+	{
+		Name:        "munderover",
+		Description: "Base with an underscript and an overscript",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+		Attributes:  []string{"accent", "accentunder"},
+	},
+	{
+		Name:        "semantics",
+		Description: "Formula with annotations, whose first child is rendered",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+}
+
+var mathmlAttributes = []Attribute{
+	{
+		Name:        "accent",
+		Description: "Whether the overscript is an accent, which is drawn closer to the base, either true or false",
+		Elements:    []string{"mover", "munderover"},
+	},
+	{
+		Name:        "accentunder",
+		Description: "Whether the underscript is an accent, which is drawn closer to the base, either true or false",
+		Elements:    []string{"munder", "munderover"},
+	},
+	{
+		Name:        "columnspan",
+		Description: "Number of columns the cell spans",
+		Elements:    []string{"mtd"},
+	},
+	{
+		Name:        "depth",
+		Description: "Depth below the baseline, like 0.5em",
+		Elements:    []string{"mpadded", "mspace"},
+	},
+	{
+		Name:        "dir",
+		Description: "Direction of the formula, either ltr or rtl",
+		Global:      true,
+	},
+	{
+		Name:        "display",
+		Description: "Whether the formula is a block or inline",
+		Elements:    []string{"math"},
+	},
+	{
+		Name:        "displaystyle",
+		Description: "Whether the formula uses the larger display style, either true or false",
+		Global:      true,
+	},
+	{
+		Name:        "encoding",
+		Description: "Format of the annotation, like application/x-tex",
+		Elements:    []string{"annotation", "annotation-xml"},
+	},
+	{
+		Name:        "fence",
+		Description: "Whether the operator is a fence, like a parenthesis, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "form",
+		Description: "Position of the operator, either prefix, infix, or postfix",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "height",
+		Description: "Height above the baseline, like 1em",
+		Elements:    []string{"mpadded", "mspace"},
+	},
+	{
+		Name:        "largeop",
+		Description: "Whether the operator is drawn larger in display style, like a sum, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "linethickness",
+		Description: "Thickness of the fraction bar, like 0 for a binomial coefficient",
+		Elements:    []string{"mfrac"},
+	},
+	{
+		Name:        "lspace",
+		Description: "Space before the operator, or the offset of the contents of mpadded",
+		Elements:    []string{"mo", "mpadded"},
+	},
+	{
+		Name:        "mathbackground",
+		Description: "Background color",
+		Global:      true,
+	},
+	{
+		Name:        "mathcolor",
+		Description: "Text color",
+		Global:      true,
+	},
+	{
+		Name:        "mathsize",
+		Description: "Font size",
+		Global:      true,
+	},
+	{
+		Name:        "mathvariant",
+		Description: "Style of a single character identifier, only normal is supported by MathML Core",
+		Elements:    []string{"mi"},
+	},
+	{
+		Name:        "maxsize",
+		Description: "Maximum size of a stretchy operator",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "minsize",
+		Description: "Minimum size of a stretchy operator",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "movablelimits",
+		Description: "Whether the scripts of the operator move to the side when it is not in display style, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "rowspan",
+		Description: "Number of rows the cell spans",
+		Elements:    []string{"mtd"},
+	},
+	{
+		Name:        "rspace",
+		Description: "Space after the operator",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "scriptlevel",
+		Description: "Script level, which controls the font size, like 1 or +1",
+		Global:      true,
+	},
+	{
+		Name:        "separator",
+		Description: "Whether the operator is a separator, like a comma, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "stretchy",
+		Description: "Whether the operator stretches to the size of its neighbors, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "symmetric",
+		Description: "Whether a stretchy operator is symmetric around the math axis, either true or false",
+		Elements:    []string{"mo"},
+	},
+	{
+		Name:        "voffset",
+		Description: "Vertical offset of the contents of mpadded",
+		Elements:    []string{"mpadded"},
+	},
+	{
+		Name:        "width",
+		Description: "Width, like 2em",
+		Elements:    []string{"mpadded", "mspace"},
+	},
+}
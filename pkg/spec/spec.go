@@ -0,0 +1,205 @@
+// Package spec contains metadata about the elements and attributes defined by
+// the WHATWG HTML standard. Validators, minifiers, pretty printers, and
+// parsers can use it to answer questions like "is <br> a void element?" or
+// "does `href` contain a URL?". The SVG and MathML elements and attributes,
+// which are case sensitive, have their own tables and lookup functions.
+//
+// The tables are generated from elements.json, attributes.json,
+// keywords.json, svg.json, and mathml.json, which are also used to generate
+// pkg/tag, pkg/attr, pkg/event, pkg/typed, pkg/svg, and pkg/mathml. To add an
+// element, attribute, or keyword, edit the JSON files and run `go generate
+// ./pkg/spec`.
+package spec
+
+import "strings"
+
+//go:generate go run ../../internal/specgen
+
+// Display describes how an element is laid out by the default style sheet of
+// the HTML standard.
+type Display uint8
+
+const (
+	// Inline elements flow with the surrounding text, like <span> or <img>.
+	Inline Display = iota
+	// Block elements start on a new line, like <div> or <p>.
+	Block
+	// None elements are not rendered, like <head> or <script>.
+	None
+)
+
+func (d Display) String() string {
+	switch d {
+	case Inline:
+		return "inline"
+	case Block:
+		return "block"
+	case None:
+		return "none"
+	}
+	return "unknown"
+}
+
+// Element describes an element defined by the HTML standard.
+type Element struct {
+	// Name is the lower case tag name, like "div".
+	Name string
+	// Description is the description from the element index of the
+	// standard. For obsolete elements it is the suggested replacement.
+	Description string
+	// Display is the element's default layout.
+	Display Display
+	// Void elements have no end tag and no children, like <br>.
+	Void bool
+	// OptionalStartTag is true if the start tag may be omitted in some
+	// contexts, like <tbody>.
+	OptionalStartTag bool
+	// OptionalEndTag is true if the end tag may be omitted in some contexts,
+	// like </li> or </p>.
+	OptionalEndTag bool
+	// Namespace is set for the roots of foreign content, <svg> and <math>.
+	Namespace string
+	// Obsolete elements are no longer part of the standard, but browsers
+	// still parse them.
+	Obsolete bool
+	// Attributes are the attributes that apply to the element in addition to
+	// the global attributes.
+	Attributes []string
+}
+
+// Attribute describes an attribute defined by the HTML standard.
+type Attribute struct {
+	// Name is the lower case attribute name, like "href".
+	Name string
+	// Description is the description from the attribute index of the
+	// standard.
+	Description string
+	// Boolean attributes are true when present and false when absent, like
+	// `disabled`.
+	Boolean bool
+	// URL is true if the value is a URL or contains URLs, like `href` or
+	// `srcset`.
+	URL bool
+	// Event is true for event handler attributes, like `onclick`, whose
+	// value is JavaScript.
+	Event bool
+	// Global attributes apply to every HTML element.
+	Global bool
+	// Elements are the elements the attribute applies to. It is empty for
+	// global attributes.
+	Elements []string
+	// Keywords are the values of an enumerated attribute, like "lazy" and
+	// "eager" for `loading`. It is empty if the value is free-form. An
+	// attribute that applies to several elements, like `type`, lists the
+	// keywords of every element.
+	Keywords []string
+	// TokenList is true if the value is a set of tokens separated by spaces,
+	// like `rel` or `sandbox`.
+	TokenList bool
+}
+
+var (
+	elementIndex   = map[string]int{}
+	attributeIndex = map[string]int{}
+)
+
+func init() {
+	for i := range elements {
+		elementIndex[elements[i].Name] = i
+	}
+	for i := range attributes {
+		attributeIndex[attributes[i].Name] = i
+	}
+}
+
+// Elements returns every element in the standard, including obsolete
+// elements, sorted by name.
+func Elements() []Element {
+	return append([]Element(nil), elements...)
+}
+
+// Attributes returns every attribute in the registry sorted by name.
+func Attributes() []Attribute {
+	return append([]Attribute(nil), attributes...)
+}
+
+// LookupElement returns the element with the given tag name. Tag names are
+// case insensitive.
+func LookupElement(name string) (Element, bool) {
+	i, ok := elementIndex[strings.ToLower(name)]
+	if !ok {
+		return Element{}, false
+	}
+	return elements[i], true
+}
+
+// LookupAttribute returns the attribute with the given name. Attribute names
+// are case insensitive.
+func LookupAttribute(name string) (Attribute, bool) {
+	i, ok := attributeIndex[strings.ToLower(name)]
+	if !ok {
+		return Attribute{}, false
+	}
+	return attributes[i], true
+}
+
+// IsVoid returns true if the element has no end tag, like <br> or <img>.
+func IsVoid(name string) bool {
+	element, ok := LookupElement(name)
+	return ok && element.Void
+}
+
+// IsBlock returns true if the element is displayed as a block by default.
+func IsBlock(name string) bool {
+	element, ok := LookupElement(name)
+	return ok && element.Display == Block
+}
+
+// IsBooleanAttribute returns true if the attribute is a boolean attribute,
+// like `checked`.
+func IsBooleanAttribute(name string) bool {
+	attribute, ok := LookupAttribute(name)
+	return ok && attribute.Boolean
+}
+
+// IsURLAttribute returns true if the attribute's value is a URL or contains
+// URLs.
+func IsURLAttribute(name string) bool {
+	attribute, ok := LookupAttribute(name)
+	return ok && attribute.URL
+}
+
+// IsEventHandler returns true if the attribute is an event handler
+// attribute, like `onclick`, whose value is JavaScript.
+func IsEventHandler(name string) bool {
+	attribute, ok := LookupAttribute(name)
+	return ok && attribute.Event
+}
+
+// AppliesTo returns true if the attribute may be used on the element. Global
+// attributes, `data-*` attributes, and `aria-*` attributes apply to every
+// element in the standard. AppliesTo returns false if the element or the
+// attribute is unknown.
+func AppliesTo(attribute string, element string) bool {
+	e, ok := LookupElement(element)
+	if !ok {
+		return false
+	}
+	attribute = strings.ToLower(attribute)
+	if strings.HasPrefix(attribute, "data-") || strings.HasPrefix(attribute, "aria-") {
+		return true
+	}
+	a, ok := LookupAttribute(attribute)
+	if !ok {
+		return false
+	}
+	if a.Global {
+		return true
+	}
+	for _, name := range e.Attributes {
+		if name == a.Name {
+			return true
+		}
+	}
+	return false
+}
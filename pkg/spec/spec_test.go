@@ -0,0 +1,234 @@
+package spec
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupElement(t *testing.T) {
+	element, ok := LookupElement("LI")
+	require.True(t, ok)
+	require.Equal(t, "li", element.Name)
+	require.Equal(t, "List item", element.Description)
+	require.Equal(t, Block, element.Display)
+	require.True(t, element.OptionalEndTag)
+	require.False(t, element.OptionalStartTag)
+	require.Equal(t, []string{"value"}, element.Attributes)
+
+	element, ok = LookupElement("marquee")
+	require.True(t, ok)
+	require.True(t, element.Obsolete)
+
+	element, ok = LookupElement("svg")
+	require.True(t, ok)
+	require.Equal(t, "http://www.w3.org/2000/svg", element.Namespace)
+
+	_, ok = LookupElement("my-element")
+	require.False(t, ok)
+}
+
+func TestLookupAttribute(t *testing.T) {
+	attribute, ok := LookupAttribute("srcset")
+	require.True(t, ok)
+	require.True(t, attribute.URL)
+	require.False(t, attribute.Boolean)
+	require.Equal(t, []string{"img", "source"}, attribute.Elements)
+
+	attribute, ok = LookupAttribute("TabIndex")
+	require.True(t, ok)
+	require.True(t, attribute.Global)
+	require.Empty(t, attribute.Elements)
+
+	_, ok = LookupAttribute("onclick-me")
+	require.False(t, ok)
+}
+
+func TestPredicates(t *testing.T) {
+	for _, name := range []string{"br", "img", "wbr", "param"} {
+		require.True(t, IsVoid(name), name)
+	}
+	for _, name := range []string{"div", "p", "template", "unknown"} {
+		require.False(t, IsVoid(name), name)
+	}
+
+	require.True(t, IsBlock("p"))
+	require.False(t, IsBlock("span"))
+	require.False(t, IsBlock("script"))
+
+	require.True(t, IsBooleanAttribute("disabled"))
+	require.False(t, IsBooleanAttribute("value"))
+
+	require.True(t, IsEventHandler("onclick"))
+	require.False(t, IsEventHandler("href"))
+
+	require.True(t, IsURLAttribute("href"))
+	require.True(t, IsURLAttribute("formaction"))
+	require.False(t, IsURLAttribute("class"))
+}
+
+func TestAppliesTo(t *testing.T) {
+	require.True(t, AppliesTo("href", "a"))
+	require.False(t, AppliesTo("href", "div"))
+	require.True(t, AppliesTo("class", "div"))
+	require.True(t, AppliesTo("data-id", "span"))
+	require.True(t, AppliesTo("aria-label", "button"))
+	require.True(t, AppliesTo("Checked", "INPUT"))
+	require.False(t, AppliesTo("checked", "select"))
+	require.False(t, AppliesTo("class", "my-element"))
+	require.False(t, AppliesTo("unknown", "div"))
+	require.True(t, AppliesTo("onclick", "div"))
+	require.True(t, AppliesTo("onpopstate", "body"))
+	require.False(t, AppliesTo("onpopstate", "div"))
+}
+
+func TestDisplayString(t *testing.T) {
+	require.Equal(t, "inline", Inline.String())
+	require.Equal(t, "block", Block.String())
+	require.Equal(t, "none", None.String())
+}
+
+func TestTablesAreSorted(t *testing.T) {
+	elements := Elements()
+	require.True(t, sort.SliceIsSorted(elements, func(i, j int) bool {
+		return elements[i].Name < elements[j].Name
+	}))
+	attributes := Attributes()
+	require.True(t, sort.SliceIsSorted(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	}))
+
+	// The returned slices are copies, so callers can't modify the registry.
+	elements[0].Name = "modified"
+	require.NotEqual(t, "modified", Elements()[0].Name)
+}
+
+// TestTablesMatchJSON catches generated tables that are out of date with
+// elements.json and attributes.json.
+func TestTablesMatchJSON(t *testing.T) {
+	var jsonElements []struct {
+		Name string `json:"name"`
+		Void bool   `json:"void"`
+	}
+	data, err := os.ReadFile("elements.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &jsonElements))
+	require.Len(t, Elements(), len(jsonElements))
+	for _, e := range jsonElements {
+		require.Equal(t, e.Void, IsVoid(e.Name), e.Name)
+	}
+
+	var jsonAttributes []struct {
+		Name    string `json:"name"`
+		Boolean bool   `json:"boolean"`
+	}
+	data, err = os.ReadFile("attributes.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &jsonAttributes))
+	require.Len(t, Attributes(), len(jsonAttributes))
+	for _, a := range jsonAttributes {
+		require.Equal(t, a.Boolean, IsBooleanAttribute(a.Name), a.Name)
+	}
+}
+
+// TestVoidElements checks the registry against the list of void elements in
+// the serialization algorithm of the WHATWG HTML standard.
+func TestVoidElements(t *testing.T) {
+	var void []string
+	for _, element := range Elements() {
+		if element.Void {
+			void = append(void, element.Name)
+		}
+	}
+	require.Equal(t, []string{
+		"area", "base", "basefont", "bgsound", "br", "col", "embed", "frame",
+		"hr", "img", "input", "keygen", "link", "meta", "param", "source",
+		"track", "wbr",
+	}, void)
+}
+
+func TestKeywords(t *testing.T) {
+	attribute, ok := LookupAttribute("rel")
+	require.True(t, ok)
+	require.True(t, attribute.TokenList)
+	require.Contains(t, attribute.Keywords, "noopener")
+
+	attribute, ok = LookupAttribute("type")
+	require.True(t, ok)
+	require.False(t, attribute.TokenList)
+	// `type` lists the keywords of <input>, <button>, <script>, and <ol>
+	// once each, even though "submit" applies to <input> and <button>.
+	require.Contains(t, attribute.Keywords, "datetime-local")
+	require.Contains(t, attribute.Keywords, "module")
+	count := 0
+	for _, keyword := range attribute.Keywords {
+		if keyword == "submit" {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+
+	attribute, ok = LookupAttribute("title")
+	require.True(t, ok)
+	require.Empty(t, attribute.Keywords)
+	require.False(t, attribute.TokenList)
+}
+
+func TestLookupSVG(t *testing.T) {
+	element, ok := LookupSVGElement("linearGradient")
+	require.True(t, ok)
+	require.Equal(t, SVGNamespace, element.Namespace)
+	require.Contains(t, element.Attributes, "gradientUnits")
+
+	// SVG names are case sensitive.
+	_, ok = LookupSVGElement("lineargradient")
+	require.False(t, ok)
+
+	attribute, ok := LookupSVGAttribute("viewBox")
+	require.True(t, ok)
+	require.Contains(t, attribute.Elements, "svg")
+	_, ok = LookupSVGAttribute("viewbox")
+	require.False(t, ok)
+
+	attribute, ok = LookupSVGAttribute("stroke-width")
+	require.True(t, ok)
+	require.True(t, attribute.Global)
+
+	attribute, ok = LookupSVGAttribute("xlink:href")
+	require.True(t, ok)
+	require.True(t, attribute.URL)
+
+	elements := SVGElements()
+	require.True(t, sort.SliceIsSorted(elements, func(i, j int) bool {
+		return elements[i].Name < elements[j].Name
+	}))
+}
+
+func TestLookupMathML(t *testing.T) {
+	element, ok := LookupMathMLElement("mo")
+	require.True(t, ok)
+	require.Equal(t, MathMLNamespace, element.Namespace)
+	require.Contains(t, element.Attributes, "stretchy")
+
+	// <math> is both an HTML element and the root of MathML.
+	html, ok := LookupElement("math")
+	require.True(t, ok)
+	math, ok := LookupMathMLElement("math")
+	require.True(t, ok)
+	require.Equal(t, html.Namespace, math.Namespace)
+
+	attribute, ok := LookupMathMLAttribute("mathcolor")
+	require.True(t, ok)
+	require.True(t, attribute.Global)
+
+	_, ok = LookupMathMLElement("circle")
+	require.False(t, ok)
+
+	elements := MathMLElements()
+	require.True(t, sort.SliceIsSorted(elements, func(i, j int) bool {
+		return elements[i].Name < elements[j].Name
+	}))
+}
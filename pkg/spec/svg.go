@@ -0,0 +1,50 @@
+package spec
+
+// SVGNamespace is the namespace of SVG elements.
+const SVGNamespace = "http://www.w3.org/2000/svg"
+
+var (
+	svgElementIndex   = map[string]int{}
+	svgAttributeIndex = map[string]int{}
+)
+
+func init() {
+	for i := range svgElements {
+		svgElementIndex[svgElements[i].Name] = i
+	}
+	for i := range svgAttributes {
+		svgAttributeIndex[svgAttributes[i].Name] = i
+	}
+}
+
+// SVGElements returns every element in the SVG 2 standard sorted by name.
+// Global attributes of SVG elements are presentation attributes, like `fill`,
+// which apply to every SVG element.
+func SVGElements() []Element {
+	return append([]Element(nil), svgElements...)
+}
+
+// SVGAttributes returns every SVG attribute in the registry sorted by name.
+func SVGAttributes() []Attribute {
+	return append([]Attribute(nil), svgAttributes...)
+}
+
+// LookupSVGElement returns the SVG element with the given name. Unlike HTML
+// tag names, SVG element names are case sensitive, like "linearGradient".
+func LookupSVGElement(name string) (Element, bool) {
+	i, ok := svgElementIndex[name]
+	if !ok {
+		return Element{}, false
+	}
+	return svgElements[i], true
+}
+
+// LookupSVGAttribute returns the SVG attribute with the given name. SVG
+// attribute names are case sensitive, like "viewBox".
+func LookupSVGAttribute(name string) (Attribute, bool) {
+	i, ok := svgAttributeIndex[name]
+	if !ok {
+		return Attribute{}, false
+	}
+	return svgAttributes[i], true
+}
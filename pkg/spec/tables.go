@@ -0,0 +1,2099 @@
+// Code generated by internal/specgen from elements.json, attributes.json, and keywords.json. DO NOT EDIT.
+
+package spec
+
+var elements = []Element{
+	{
+		Name:        "a",
+		Description: "Hyperlink",
+		Display:     Inline,
+		Attributes:  []string{"href", "hreflang", "ping", "referrerpolicy", "rel", "target", "type"},
+	},
+	{
+		Name:        "abbr",
+		Description: "Abbreviation",
+		Display:     Inline,
+	},
+	{
+		Name:        "acronym",
+		Description: "Use the abbr element.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "address",
+		Description: "Contact information for a page or article element",
+		Display:     Block,
+	},
+	{
+		Name:        "applet",
+		Description: "Use embed or object instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "area",
+		Description: "Hyperlink or dead area on an image map",
+		Display:     None,
+		Void:        true,
+		Attributes:  []string{"alt", "coords", "href", "ping", "referrerpolicy", "rel", "shape", "target"},
+	},
+	{
+		Name:        "article",
+		Description: "Self-contained syndicatable or reusable composition",
+		Display:     Block,
+	},
+	{
+		Name:        "aside",
+		Description: "Sidebar for tangentially related content",
+		Display:     Block,
+	},
+	{
+		Name:        "audio",
+		Description: "Audio player",
+		Display:     Inline,
+		Attributes:  []string{"autoplay", "controls", "crossorigin", "loop", "muted", "preload", "src"},
+	},
+	{
+		Name:        "b",
+		Description: "Keywords",
+		Display:     Inline,
+	},
+	{
+		Name:        "base",
+		Description: "Base URL and default target navigable for hyperlinks and forms",
+		Display:     None,
+		Void:        true,
+		Attributes:  []string{"href", "target"},
+	},
+	{
+		Name:        "basefont",
+		Description: "Use CSS instead.",
+		Display:     None,
+		Void:        true,
+		Obsolete:    true,
+	},
+	{
+		Name:        "bdi",
+		Description: "Text directionality isolation",
+		Display:     Inline,
+	},
+	{
+		Name:        "bdo",
+		Description: "Text directionality formatting",
+		Display:     Inline,
+	},
+	{
+		Name:        "bgsound",
+		Description: "Use audio instead.",
+		Display:     None,
+		Void:        true,
+		Obsolete:    true,
+	},
+	{
+		Name:        "big",
+		Description: "Use CSS instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "blink",
+		Description: "Use CSS animations and transitions instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "blockquote",
+		Description: "A section quoted from another source",
+		Display:     Block,
+		Attributes:  []string{"cite"},
+	},
+	{
+		Name:             "body",
+		Description:      "Document body",
+		Display:          Block,
+		OptionalStartTag: true,
+		OptionalEndTag:   true,
+		Attributes:       []string{"onafterprint", "onbeforeprint", "onbeforeunload", "onhashchange", "onlanguagechange", "onmessage", "onmessageerror", "onoffline", "ononline", "onpagehide", "onpagereveal", "onpageshow", "onpageswap", "onpopstate", "onrejectionhandled", "onstorage", "onunhandledrejection", "onunload"},
+	},
+	{
+		Name:        "br",
+		Description: "Line break, e.g. in poem or postal address",
+		Display:     Inline,
+		Void:        true,
+	},
+	{
+		Name:        "button",
+		Description: "Button control",
+		Display:     Inline,
+		Attributes:  []string{"disabled", "form", "formaction", "formenctype", "formmethod", "formnovalidate", "formtarget", "name", "popovertarget", "popovertargetaction", "type", "value"},
+	},
+	{
+		Name:        "canvas",
+		Description: "Scriptable bitmap canvas",
+		Display:     Inline,
+		Attributes:  []string{"height", "width"},
+	},
+	{
+		Name:           "caption",
+		Description:    "Table caption",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "center",
+		Description: "Use CSS instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "cite",
+		Description: "Title of a work",
+		Display:     Inline,
+	},
+	{
+		Name:        "code",
+		Description: "Computer code",
+		Display:     Inline,
+	},
+	{
+		Name:        "col",
+		Description: "Table column",
+		Display:     Block,
+		Void:        true,
+		Attributes:  []string{"span"},
+	},
+	{
+		Name:             "colgroup",
+		Description:      "Group of columns in a table",
+		Display:          Block,
+		OptionalStartTag: true,
+		OptionalEndTag:   true,
+		Attributes:       []string{"span"},
+	},
+	{
+		Name:        "data",
+		Description: "Machine-readable equivalent",
+		Display:     Inline,
+		Attributes:  []string{"value"},
+	},
+	{
+		Name:        "datalist",
+		Description: "Container for options for combo box control",
+		Display:     None,
+	},
+	{
+		Name:           "dd",
+		Description:    "Content for corresponding dt element(s)",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "del",
+		Description: "A removal from the document",
+		Display:     Inline,
+		Attributes:  []string{"cite", "datetime"},
+	},
+	{
+		Name:        "details",
+		Description: "Disclosure control for hiding details",
+		Display:     Block,
+		Attributes:  []string{"name", "open"},
+	},
+	{
+		Name:        "dfn",
+		Description: "Defining instance",
+		Display:     Inline,
+	},
+	{
+		Name:        "dialog",
+		Description: "Dialog box or window",
+		Display:     Block,
+		Attributes:  []string{"open"},
+	},
+	{
+		Name:        "dir",
+		Description: "Use ul instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "div",
+		Description: "Generic flow container, or container for name-value groups in dl elements",
+		Display:     Block,
+	},
+	{
+		Name:        "dl",
+		Description: "Association list consisting of zero or more name-value groups",
+		Display:     Block,
+	},
+	{
+		Name:           "dt",
+		Description:    "Legend for corresponding dd element(s)",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "em",
+		Description: "Stress emphasis",
+		Display:     Inline,
+	},
+	{
+		Name:        "embed",
+		Description: "Plugin",
+		Display:     Inline,
+		Void:        true,
+		Attributes:  []string{"height", "src", "type", "width"},
+	},
+	{
+		Name:        "fieldset",
+		Description: "Group of form controls",
+		Display:     Block,
+		Attributes:  []string{"disabled", "form", "name"},
+	},
+	{
+		Name:        "figcaption",
+		Description: "Caption for figure",
+		Display:     Block,
+	},
+	{
+		Name:        "figure",
+		Description: "Figure with optional caption",
+		Display:     Block,
+	},
+	{
+		Name:        "font",
+		Description: "Use CSS instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "footer",
+		Description: "Footer for a page or section",
+		Display:     Block,
+	},
+	{
+		Name:        "form",
+		Description: "User-submittable form",
+		Display:     Block,
+		Attributes:  []string{"accept-charset", "action", "autocomplete", "enctype", "method", "name", "novalidate", "rel", "target"},
+	},
+	{
+		Name:        "frame",
+		Description: "Use iframe and CSS instead, or use server-side includes.",
+		Display:     Block,
+		Void:        true,
+		Obsolete:    true,
+	},
+	{
+		Name:        "frameset",
+		Description: "Use iframe and CSS instead, or use server-side includes.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "h1",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:        "h2",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:        "h3",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:        "h4",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:        "h5",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:        "h6",
+		Description: "Heading",
+		Display:     Block,
+	},
+	{
+		Name:             "head",
+		Description:      "Container for document metadata",
+		Display:          None,
+		OptionalStartTag: true,
+		OptionalEndTag:   true,
+	},
+	{
+		Name:        "header",
+		Description: "Introductory or navigational aids for a page or section",
+		Display:     Block,
+	},
+	{
+		Name:        "hgroup",
+		Description: "Heading container",
+		Display:     Block,
+	},
+	{
+		Name:        "hr",
+		Description: "Thematic break",
+		Display:     Block,
+		Void:        true,
+	},
+	{
+		Name:             "html",
+		Description:      "Root element",
+		Display:          Block,
+		OptionalStartTag: true,
+		OptionalEndTag:   true,
+	},
+	{
+		Name:        "i",
+		Description: "Alternate voice",
+		Display:     Inline,
+	},
+	{
+		Name:        "iframe",
+		Description: "Child navigable",
+		Display:     Inline,
+		Attributes:  []string{"allow", "allowfullscreen", "height", "loading", "name", "referrerpolicy", "sandbox", "src", "srcdoc", "width"},
+	},
+	{
+		Name:        "img",
+		Description: "Image",
+		Display:     Inline,
+		Void:        true,
+		Attributes:  []string{"alt", "crossorigin", "decoding", "fetchpriority", "height", "ismap", "loading", "referrerpolicy", "sizes", "src", "srcset", "usemap", "width"},
+	},
+	{
+		Name:        "input",
+		Description: "Form control",
+		Display:     Inline,
+		Void:        true,
+		Attributes:  []string{"accept", "alt", "autocomplete", "checked", "dirname", "disabled", "form", "formaction", "formenctype", "formmethod", "formnovalidate", "formtarget", "height", "list", "max", "maxlength", "min", "minlength", "multiple", "name", "pattern", "placeholder", "popovertarget", "popovertargetaction", "readonly", "required", "size", "src", "step", "type", "value", "width"},
+	},
+	{
+		Name:        "ins",
+		Description: "An addition to the document",
+		Display:     Inline,
+		Attributes:  []string{"cite", "datetime"},
+	},
+	{
+		Name:        "isindex",
+		Description: "Use an explicit form and text control combination instead.",
+		Display:     None,
+		Obsolete:    true,
+	},
+	{
+		Name:        "kbd",
+		Description: "User input",
+		Display:     Inline,
+	},
+	{
+		Name:        "keygen",
+		Description: "Use the WebCrypto API or WebAuthn instead.",
+		Display:     Inline,
+		Void:        true,
+		Obsolete:    true,
+	},
+	{
+		Name:        "label",
+		Description: "Caption for a form control",
+		Display:     Inline,
+		Attributes:  []string{"for"},
+	},
+	{
+		Name:        "legend",
+		Description: "Caption for fieldset",
+		Display:     Block,
+	},
+	{
+		Name:           "li",
+		Description:    "List item",
+		Display:        Block,
+		OptionalEndTag: true,
+		Attributes:     []string{"value"},
+	},
+	{
+		Name:        "link",
+		Description: "Link metadata",
+		Display:     None,
+		Void:        true,
+		Attributes:  []string{"as", "blocking", "color", "crossorigin", "disabled", "fetchpriority", "href", "hreflang", "imagesizes", "imagesrcset", "integrity", "media", "referrerpolicy", "rel", "sizes", "type"},
+	},
+	{
+		Name:        "listing",
+		Description: "Use pre and code instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "main",
+		Description: "Container for the dominant contents of the document",
+		Display:     Block,
+	},
+	{
+		Name:        "map",
+		Description: "Image map",
+		Display:     Inline,
+		Attributes:  []string{"name"},
+	},
+	{
+		Name:        "mark",
+		Description: "Highlight",
+		Display:     Inline,
+	},
+	{
+		Name:        "marquee",
+		Description: "Use CSS animations and transitions instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "math",
+		Description: "MathML root",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/1998/Math/MathML",
+	},
+	{
+		Name:        "menu",
+		Description: "Menu of commands",
+		Display:     Block,
+	},
+	{
+		Name:        "menuitem",
+		Description: "Use script to handle the contextmenu event instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "meta",
+		Description: "Text metadata",
+		Display:     None,
+		Void:        true,
+		Attributes:  []string{"charset", "content", "http-equiv", "media", "name"},
+	},
+	{
+		Name:        "meter",
+		Description: "Gauge",
+		Display:     Inline,
+		Attributes:  []string{"high", "low", "max", "min", "optimum", "value"},
+	},
+	{
+		Name:        "multicol",
+		Description: "Use CSS instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "nav",
+		Description: "Section with navigational links",
+		Display:     Block,
+	},
+	{
+		Name:        "nextid",
+		Description: "Use GUIDs instead.",
+		Display:     None,
+		Obsolete:    true,
+	},
+	{
+		Name:        "nobr",
+		Description: "Use CSS instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "noembed",
+		Description: "Use object instead of embed when fallback is necessary.",
+		Display:     None,
+		Obsolete:    true,
+	},
+	{
+		Name:        "noframes",
+		Description: "Use iframe and CSS instead, or use server-side includes.",
+		Display:     None,
+		Obsolete:    true,
+	},
+	{
+		Name:        "noscript",
+		Description: "Fallback content for script",
+		Display:     None,
+	},
+	{
+		Name:        "object",
+		Description: "Image, child navigable, or plugin",
+		Display:     Inline,
+		Attributes:  []string{"data", "form", "height", "name", "type", "width"},
+	},
+	{
+		Name:        "ol",
+		Description: "Ordered list",
+		Display:     Block,
+		Attributes:  []string{"reversed", "start", "type"},
+	},
+	{
+		Name:           "optgroup",
+		Description:    "Group of options in a list box",
+		Display:        Block,
+		OptionalEndTag: true,
+		Attributes:     []string{"disabled", "label"},
+	},
+	{
+		Name:           "option",
+		Description:    "Option in a list box or combo box control",
+		Display:        Block,
+		OptionalEndTag: true,
+		Attributes:     []string{"disabled", "label", "selected", "value"},
+	},
+	{
+		Name:        "output",
+		Description: "Calculated output value",
+		Display:     Inline,
+		Attributes:  []string{"for", "form", "name", "value"},
+	},
+	{
+		Name:           "p",
+		Description:    "Paragraph",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "param",
+		Description: "Use the data attribute of the object element to set the URL of the external resource.",
+		Display:     None,
+		Void:        true,
+		Obsolete:    true,
+	},
+	{
+		Name:        "picture",
+		Description: "Image",
+		Display:     Inline,
+	},
+	{
+		Name:        "plaintext",
+		Description: "Use the \"text/plain\" MIME type instead.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+	{
+		Name:        "pre",
+		Description: "Block of preformatted text",
+		Display:     Block,
+	},
+	{
+		Name:        "progress",
+		Description: "Progress bar",
+		Display:     Inline,
+		Attributes:  []string{"max", "value"},
+	},
+	{
+		Name:        "q",
+		Description: "Quotation",
+		Display:     Inline,
+		Attributes:  []string{"cite"},
+	},
+	{
+		Name:        "rb",
+		Description: "Provide the ruby base directly inside the ruby element or in a span instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:           "rp",
+		Description:    "Parenthesis for ruby annotation text",
+		Display:        None,
+		OptionalEndTag: true,
+	},
+	{
+		Name:           "rt",
+		Description:    "Ruby annotation text",
+		Display:        Inline,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "rtc",
+		Description: "Nest ruby elements instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "ruby",
+		Description: "Ruby annotation(s)",
+		Display:     Inline,
+	},
+	{
+		Name:        "s",
+		Description: "Inaccurate text",
+		Display:     Inline,
+	},
+	{
+		Name:        "samp",
+		Description: "Computer output",
+		Display:     Inline,
+	},
+	{
+		Name:        "script",
+		Description: "Embedded script",
+		Display:     None,
+		Attributes:  []string{"async", "blocking", "crossorigin", "defer", "fetchpriority", "integrity", "nomodule", "referrerpolicy", "src", "type"},
+	},
+	{
+		Name:        "search",
+		Description: "Container for search controls",
+		Display:     Block,
+	},
+	{
+		Name:        "section",
+		Description: "Generic document or application section",
+		Display:     Block,
+	},
+	{
+		Name:        "select",
+		Description: "List box control",
+		Display:     Inline,
+		Attributes:  []string{"autocomplete", "disabled", "form", "multiple", "name", "required", "size"},
+	},
+	{
+		Name:        "selectedcontent",
+		Description: "Mirrors content from an option",
+		Display:     Inline,
+	},
+	{
+		Name:        "slot",
+		Description: "Shadow tree slot",
+		Display:     Inline,
+		Attributes:  []string{"name"},
+	},
+	{
+		Name:        "small",
+		Description: "Side comment",
+		Display:     Inline,
+	},
+	{
+		Name:        "source",
+		Description: "Image source for img or media source for video or audio",
+		Display:     Inline,
+		Void:        true,
+		Attributes:  []string{"height", "media", "sizes", "src", "srcset", "type", "width"},
+	},
+	{
+		Name:        "spacer",
+		Description: "Use CSS instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "span",
+		Description: "Generic phrasing container",
+		Display:     Inline,
+	},
+	{
+		Name:        "strike",
+		Description: "Use del instead if the element is marking an edit, otherwise use s instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "strong",
+		Description: "Importance",
+		Display:     Inline,
+	},
+	{
+		Name:        "style",
+		Description: "Embedded styling information",
+		Display:     None,
+		Attributes:  []string{"blocking", "media"},
+	},
+	{
+		Name:        "sub",
+		Description: "Subscript",
+		Display:     Inline,
+	},
+	{
+		Name:        "summary",
+		Description: "Caption for details",
+		Display:     Block,
+	},
+	{
+		Name:        "sup",
+		Description: "Superscript",
+		Display:     Inline,
+	},
+	{
+		Name:        "svg",
+		Description: "SVG root",
+		Display:     Inline,
+		Namespace:   "http://www.w3.org/2000/svg",
+	},
+	{
+		Name:        "table",
+		Description: "Table",
+		Display:     Block,
+	},
+	{
+		Name:             "tbody",
+		Description:      "Group of rows in a table",
+		Display:          Block,
+		OptionalStartTag: true,
+		OptionalEndTag:   true,
+	},
+	{
+		Name:           "td",
+		Description:    "Table cell",
+		Display:        Block,
+		OptionalEndTag: true,
+		Attributes:     []string{"colspan", "headers", "rowspan"},
+	},
+	{
+		Name:        "template",
+		Description: "Template",
+		Display:     None,
+	},
+	{
+		Name:        "textarea",
+		Description: "Multiline text controls",
+		Display:     Inline,
+		Attributes:  []string{"autocomplete", "cols", "dirname", "disabled", "form", "maxlength", "minlength", "name", "placeholder", "readonly", "required", "rows", "wrap"},
+	},
+	{
+		Name:           "tfoot",
+		Description:    "Group of footer rows in a table",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:           "th",
+		Description:    "Table header cell",
+		Display:        Block,
+		OptionalEndTag: true,
+		Attributes:     []string{"abbr", "colspan", "headers", "rowspan", "scope"},
+	},
+	{
+		Name:           "thead",
+		Description:    "Group of heading rows in a table",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "time",
+		Description: "Machine-readable equivalent of date- or time-related data",
+		Display:     Inline,
+		Attributes:  []string{"datetime"},
+	},
+	{
+		Name:        "title",
+		Description: "Document title",
+		Display:     None,
+	},
+	{
+		Name:           "tr",
+		Description:    "Table row",
+		Display:        Block,
+		OptionalEndTag: true,
+	},
+	{
+		Name:        "track",
+		Description: "Timed text track",
+		Display:     Inline,
+		Void:        true,
+		Attributes:  []string{"default", "kind", "label", "src", "srclang"},
+	},
+	{
+		Name:        "tt",
+		Description: "Use kbd, var, code, or samp, or use CSS instead.",
+		Display:     Inline,
+		Obsolete:    true,
+	},
+	{
+		Name:        "u",
+		Description: "Unarticulated annotation",
+		Display:     Inline,
+	},
+	{
+		Name:        "ul",
+		Description: "List",
+		Display:     Block,
+	},
+	{
+		Name:        "var",
+		Description: "Variable",
+		Display:     Inline,
+	},
+	{
+		Name:        "video",
+		Description: "Video player",
+		Display:     Inline,
+		Attributes:  []string{"autoplay", "controls", "crossorigin", "height", "loop", "muted", "playsinline", "poster", "preload", "src", "width"},
+	},
+	{
+		Name:        "wbr",
+		Description: "Line breaking opportunity",
+		Display:     Inline,
+		Void:        true,
+	},
+	{
+		Name:        "xmp",
+		Description: "Use pre and code instead, and escape \"<\" and \"&\" characters as \"&lt;\" and \"&amp;\" respectively.",
+		Display:     Block,
+		Obsolete:    true,
+	},
+}
+
+var attributes = []Attribute{
+	{
+		Name:        "abbr",
+		Description: "Alternative label to use for the header cell when referencing the cell in other contexts",
+		Elements:    []string{"th"},
+	},
+	{
+		Name:        "accept",
+		Description: "Hint for expected file type in file upload controls",
+		Elements:    []string{"input"},
+	},
+	{
+		Name:        "accept-charset",
+		Description: "Character encodings to use for form submission",
+		Elements:    []string{"form"},
+	},
+	{
+		Name:        "accesskey",
+		Description: "Keyboard shortcut to activate or focus element",
+		Global:      true,
+	},
+	{
+		Name:        "action",
+		Description: "URL to use for form submission",
+		URL:         true,
+		Elements:    []string{"form"},
+	},
+	{
+		Name:        "allow",
+		Description: "Permissions policy to be applied to the iframe's contents",
+		Elements:    []string{"iframe"},
+	},
+	{
+		Name:        "allowfullscreen",
+		Description: "Whether to allow the iframe's contents to use requestFullscreen()",
+		Boolean:     true,
+		Elements:    []string{"iframe"},
+	},
+	{
+		Name:        "alt",
+		Description: "Replacement text for use when images are not available",
+		Elements:    []string{"area", "img", "input"},
+	},
+	{
+		Name:        "as",
+		Description: "Potential destination for a preload request",
+		Elements:    []string{"link"},
+		Keywords:    []string{"audio", "document", "embed", "fetch", "font", "image", "json", "object", "script", "style", "track", "video", "worker"},
+	},
+	{
+		Name:        "async",
+		Description: "Execute script when available, without blocking while fetching",
+		Boolean:     true,
+		Elements:    []string{"script"},
+	},
+	{
+		Name:        "autocapitalize",
+		Description: "Recommended autocapitalization behavior (for supported input methods)",
+		Global:      true,
+		Keywords:    []string{"off", "none", "on", "sentences", "words", "characters"},
+	},
+	{
+		Name:        "autocomplete",
+		Description: "Hint for form autofill feature",
+		Elements:    []string{"form", "input", "select", "textarea"},
+		Keywords:    []string{"on", "off", "shipping", "billing", "home", "work", "mobile", "fax", "pager", "name", "honorific-prefix", "given-name", "additional-name", "family-name", "honorific-suffix", "nickname", "username", "new-password", "current-password", "one-time-code", "organization-title", "organization", "street-address", "address-line1", "address-line2", "address-line3", "address-level4", "address-level3", "address-level2", "address-level1", "country", "country-name", "postal-code", "cc-name", "cc-given-name", "cc-additional-name", "cc-family-name", "cc-number", "cc-exp", "cc-exp-month", "cc-exp-year", "cc-csc", "cc-type", "transaction-currency", "transaction-amount", "language", "bday", "bday-day", "bday-month", "bday-year", "sex", "url", "photo", "tel", "tel-country-code", "tel-national", "tel-area-code", "tel-local", "tel-extension", "email", "impp", "webauthn"},
+		TokenList:   true,
+	},
+	{
+		Name:        "autofocus",
+		Description: "Automatically focus the element when the page is loaded",
+		Boolean:     true,
+		Global:      true,
+	},
+	{
+		Name:        "autoplay",
+		Description: "Hint that the media resource can be started automatically when the page is loaded",
+		Boolean:     true,
+		Elements:    []string{"audio", "video"},
+	},
+	{
+		Name:        "blocking",
+		Description: "Whether the element is potentially render-blocking",
+		Elements:    []string{"link", "script", "style"},
+	},
+	{
+		Name:        "charset",
+		Description: "Character encoding declaration",
+		Elements:    []string{"meta"},
+	},
+	{
+		Name:        "checked",
+		Description: "Whether the control is checked",
+		Boolean:     true,
+		Elements:    []string{"input"},
+	},
+	{
+		Name:        "cite",
+		Description: "Link to the source of the quotation or more information about the edit",
+		URL:         true,
+		Elements:    []string{"blockquote", "del", "ins", "q"},
+	},
+	{
+		Name:        "class",
+		Description: "Classes to which the element belongs",
+		Global:      true,
+	},
+	{
+		Name:        "color",
+		Description: "Color to use when customizing a site's icon",
+		Elements:    []string{"link"},
+	},
+	{
+		Name:        "cols",
+		Description: "Maximum number of characters per line",
+		Elements:    []string{"textarea"},
+	},
+	{
+		Name:        "colspan",
+		Description: "Number of columns that the cell is to span",
+		Elements:    []string{"td", "th"},
+	},
+	{
+		Name:        "content",
+		Description: "Value of the element",
+		Elements:    []string{"meta"},
+	},
+	{
+		Name:        "contenteditable",
+		Description: "Whether the element is editable",
+		Global:      true,
+		Keywords:    []string{"true", "false", "plaintext-only"},
+	},
+	{
+		Name:        "controls",
+		Description: "Show user agent controls",
+		Boolean:     true,
+		Elements:    []string{"audio", "video"},
+	},
+	{
+		Name:        "coords",
+		Description: "Coordinates for the shape to be created in an image map",
+		Elements:    []string{"area"},
+	},
+	{
+		Name:        "crossorigin",
+		Description: "How the element handles crossorigin requests",
+		Elements:    []string{"audio", "img", "link", "script", "video"},
+		Keywords:    []string{"anonymous", "use-credentials"},
+	},
+	{
+		Name:        "data",
+		Description: "Address of the resource",
+		URL:         true,
+		Elements:    []string{"object"},
+	},
+	{
+		Name:        "datetime",
+		Description: "Date and (optionally) time of the change, or the machine-readable value",
+		Elements:    []string{"del", "ins", "time"},
+	},
+	{
+		Name:        "decoding",
+		Description: "Decoding hint to use when processing this image for presentation",
+		Elements:    []string{"img"},
+		Keywords:    []string{"sync", "async", "auto"},
+	},
+	{
+		Name:        "default",
+		Description: "Enable the track if no other text track is more suitable",
+		Boolean:     true,
+		Elements:    []string{"track"},
+	},
+	{
+		Name:        "defer",
+		Description: "Defer script execution",
+		Boolean:     true,
+		Elements:    []string{"script"},
+	},
+	{
+		Name:        "dir",
+		Description: "The text directionality of the element",
+		Global:      true,
+		Keywords:    []string{"ltr", "rtl", "auto"},
+	},
+	{
+		Name:        "dirname",
+		Description: "Name of form control to use for sending the element's directionality in form submission",
+		Elements:    []string{"input", "textarea"},
+	},
+	{
+		Name:        "disabled",
+		Description: "Whether the form control is disabled",
+		Boolean:     true,
+		Elements:    []string{"button", "fieldset", "input", "link", "optgroup", "option", "select", "textarea"},
+	},
+	{
+		Name:        "draggable",
+		Description: "Whether the element is draggable",
+		Global:      true,
+		Keywords:    []string{"true", "false"},
+	},
+	{
+		Name:        "enctype",
+		Description: "Entry list encoding type to use for form submission",
+		Elements:    []string{"form"},
+		Keywords:    []string{"application/x-www-form-urlencoded", "multipart/form-data", "text/plain"},
+	},
+	{
+		Name:        "enterkeyhint",
+		Description: "Hint for selecting an enter key action",
+		Global:      true,
+		Keywords:    []string{"enter", "done", "go", "next", "previous", "search", "send"},
+	},
+	{
+		Name:        "fetchpriority",
+		Description: "Sets the priority for fetches initiated by the element",
+		Elements:    []string{"img", "link", "script"},
+		Keywords:    []string{"high", "low", "auto"},
+	},
+	{
+		Name:        "for",
+		Description: "Associate the label or output with form controls",
+		Elements:    []string{"label", "output"},
+	},
+	{
+		Name:        "form",
+		Description: "Associates the element with a form element",
+		Elements:    []string{"button", "fieldset", "input", "object", "output", "select", "textarea"},
+	},
+	{
+		Name:        "formaction",
+		Description: "URL to use for form submission",
+		URL:         true,
+		Elements:    []string{"button", "input"},
+	},
+	{
+		Name:        "formenctype",
+		Description: "Entry list encoding type to use for form submission",
+		Elements:    []string{"button", "input"},
+		Keywords:    []string{"application/x-www-form-urlencoded", "multipart/form-data", "text/plain"},
+	},
+	{
+		Name:        "formmethod",
+		Description: "Variant to use for form submission",
+		Elements:    []string{"button", "input"},
+		Keywords:    []string{"get", "post", "dialog"},
+	},
+	{
+		Name:        "formnovalidate",
+		Description: "Bypass form control validation for form submission",
+		Boolean:     true,
+		Elements:    []string{"button", "input"},
+	},
+	{
+		Name:        "formtarget",
+		Description: "Navigable for form submission",
+		Elements:    []string{"button", "input"},
+		Keywords:    []string{"_blank", "_self", "_parent", "_top"},
+	},
+	{
+		Name:        "headers",
+		Description: "The header cells for this cell",
+		Elements:    []string{"td", "th"},
+	},
+	{
+		Name:        "height",
+		Description: "Vertical dimension",
+		Elements:    []string{"canvas", "embed", "iframe", "img", "input", "object", "source", "video"},
+	},
+	{
+		Name:        "hidden",
+		Description: "Whether the element is relevant",
+		Boolean:     true,
+		Global:      true,
+	},
+	{
+		Name:        "high",
+		Description: "Low limit of high range",
+		Elements:    []string{"meter"},
+	},
+	{
+		Name:        "href",
+		Description: "Address of the hyperlink or the document base URL",
+		URL:         true,
+		Elements:    []string{"a", "area", "base", "link"},
+	},
+	{
+		Name:        "hreflang",
+		Description: "Language of the linked resource",
+		Elements:    []string{"a", "link"},
+	},
+	{
+		Name:        "http-equiv",
+		Description: "Pragma directive",
+		Elements:    []string{"meta"},
+		Keywords:    []string{"content-type", "default-style", "refresh", "x-ua-compatible", "content-security-policy"},
+	},
+	{
+		Name:        "id",
+		Description: "The element's ID",
+		Global:      true,
+	},
+	{
+		Name:        "imagesizes",
+		Description: "Image sizes for different page layouts",
+		Elements:    []string{"link"},
+	},
+	{
+		Name:        "imagesrcset",
+		Description: "Images to use in different situations, e.g., high-resolution displays, small monitors, etc.",
+		URL:         true,
+		Elements:    []string{"link"},
+	},
+	{
+		Name:        "inert",
+		Description: "Whether the element is inert",
+		Boolean:     true,
+		Global:      true,
+	},
+	{
+		Name:        "inputmode",
+		Description: "Hint for selecting an input modality",
+		Global:      true,
+		Keywords:    []string{"none", "text", "tel", "url", "email", "numeric", "decimal", "search"},
+	},
+	{
+		Name:        "integrity",
+		Description: "Integrity metadata used in Subresource Integrity checks",
+		Elements:    []string{"link", "script"},
+	},
+	{
+		Name:        "is",
+		Description: "Creates a customized built-in element",
+		Global:      true,
+	},
+	{
+		Name:        "ismap",
+		Description: "Whether the image is a server-side image map",
+		Boolean:     true,
+		Elements:    []string{"img"},
+	},
+	{
+		Name:        "itemid",
+		Description: "Global identifier for a microdata item",
+		URL:         true,
+		Global:      true,
+	},
+	{
+		Name:        "itemprop",
+		Description: "Property names of a microdata item",
+		Global:      true,
+	},
+	{
+		Name:        "itemref",
+		Description: "Referenced elements",
+		Global:      true,
+	},
+	{
+		Name:        "itemscope",
+		Description: "Introduces a microdata item",
+		Boolean:     true,
+		Global:      true,
+	},
+	{
+		Name:        "itemtype",
+		Description: "Item types of a microdata item",
+		URL:         true,
+		Global:      true,
+	},
+	{
+		Name:        "kind",
+		Description: "The type of text track",
+		Elements:    []string{"track"},
+		Keywords:    []string{"subtitles", "captions", "descriptions", "chapters", "metadata"},
+	},
+	{
+		Name:        "label",
+		Description: "User-visible label",
+		Elements:    []string{"optgroup", "option", "track"},
+	},
+	{
+		Name:        "lang",
+		Description: "Language of the element",
+		Global:      true,
+	},
+	{
+		Name:        "list",
+		Description: "List of autocomplete options",
+		Elements:    []string{"input"},
+	},
+	{
+		Name:        "loading",
+		Description: "Used when determining loading deferral",
+		Elements:    []string{"iframe", "img"},
+		Keywords:    []string{"lazy", "eager"},
+	},
+	{
+		Name:        "loop",
+		Description: "Whether to loop the media resource",
+		Boolean:     true,
+		Elements:    []string{"audio", "video"},
+	},
+	{
+		Name:        "low",
+		Description: "High limit of low range",
+		Elements:    []string{"meter"},
+	},
+	{
+		Name:        "max",
+		Description: "Maximum value",
+		Elements:    []string{"input", "meter", "progress"},
+	},
+	{
+		Name:        "maxlength",
+		Description: "Maximum length of value",
+		Elements:    []string{"input", "textarea"},
+	},
+	{
+		Name:        "media",
+		Description: "Applicable media",
+		Elements:    []string{"link", "meta", "source", "style"},
+	},
+	{
+		Name:        "method",
+		Description: "Variant to use for form submission",
+		Elements:    []string{"form"},
+		Keywords:    []string{"get", "post", "dialog"},
+	},
+	{
+		Name:        "min",
+		Description: "Minimum value",
+		Elements:    []string{"input", "meter"},
+	},
+	{
+		Name:        "minlength",
+		Description: "Minimum length of value",
+		Elements:    []string{"input", "textarea"},
+	},
+	{
+		Name:        "multiple",
+		Description: "Whether to allow multiple values",
+		Boolean:     true,
+		Elements:    []string{"input", "select"},
+	},
+	{
+		Name:        "muted",
+		Description: "Whether to mute the media resource by default",
+		Boolean:     true,
+		Elements:    []string{"audio", "video"},
+	},
+	{
+		Name:        "name",
+		Description: "Name of the element",
+		Elements:    []string{"button", "details", "fieldset", "form", "iframe", "input", "map", "meta", "object", "output", "select", "slot", "textarea"},
+	},
+	{
+		Name:        "nomodule",
+		Description: "Prevents execution in user agents that support module scripts",
+		Boolean:     true,
+		Elements:    []string{"script"},
+	},
+	{
+		Name:        "nonce",
+		Description: "Cryptographic nonce used in Content Security Policy checks",
+		Global:      true,
+	},
+	{
+		Name:        "novalidate",
+		Description: "Bypass form control validation for form submission",
+		Boolean:     true,
+		Elements:    []string{"form"},
+	},
+	{
+		Name:        "onabort",
+		Description: "abort event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onafterprint",
+		Description: "afterprint event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onauxclick",
+		Description: "auxclick event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onbeforeinput",
+		Description: "beforeinput event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onbeforematch",
+		Description: "beforematch event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onbeforeprint",
+		Description: "beforeprint event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onbeforetoggle",
+		Description: "beforetoggle event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onbeforeunload",
+		Description: "beforeunload event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onblur",
+		Description: "blur event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncancel",
+		Description: "cancel event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncanplay",
+		Description: "canplay event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncanplaythrough",
+		Description: "canplaythrough event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onchange",
+		Description: "change event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onclick",
+		Description: "click event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onclose",
+		Description: "close event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncommand",
+		Description: "command event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncontextlost",
+		Description: "contextlost event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncontextmenu",
+		Description: "contextmenu event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncontextrestored",
+		Description: "contextrestored event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncopy",
+		Description: "copy event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncuechange",
+		Description: "cuechange event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oncut",
+		Description: "cut event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondblclick",
+		Description: "dblclick event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondrag",
+		Description: "drag event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondragend",
+		Description: "dragend event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondragenter",
+		Description: "dragenter event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondragleave",
+		Description: "dragleave event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondragover",
+		Description: "dragover event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondragstart",
+		Description: "dragstart event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondrop",
+		Description: "drop event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ondurationchange",
+		Description: "durationchange event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onemptied",
+		Description: "emptied event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onended",
+		Description: "ended event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onerror",
+		Description: "error event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onfocus",
+		Description: "focus event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onformdata",
+		Description: "formdata event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onhashchange",
+		Description: "hashchange event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "oninput",
+		Description: "input event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "oninvalid",
+		Description: "invalid event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onkeydown",
+		Description: "keydown event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onkeypress",
+		Description: "keypress event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onkeyup",
+		Description: "keyup event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onlanguagechange",
+		Description: "languagechange event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onload",
+		Description: "load event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onloadeddata",
+		Description: "loadeddata event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onloadedmetadata",
+		Description: "loadedmetadata event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onloadstart",
+		Description: "loadstart event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmessage",
+		Description: "message event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onmessageerror",
+		Description: "messageerror event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onmousedown",
+		Description: "mousedown event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmouseenter",
+		Description: "mouseenter event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmouseleave",
+		Description: "mouseleave event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmousemove",
+		Description: "mousemove event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmouseout",
+		Description: "mouseout event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmouseover",
+		Description: "mouseover event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onmouseup",
+		Description: "mouseup event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onoffline",
+		Description: "offline event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "ononline",
+		Description: "online event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onpagehide",
+		Description: "pagehide event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onpagereveal",
+		Description: "pagereveal event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onpageshow",
+		Description: "pageshow event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onpageswap",
+		Description: "pageswap event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onpaste",
+		Description: "paste event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onpause",
+		Description: "pause event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onplay",
+		Description: "play event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onplaying",
+		Description: "playing event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onpopstate",
+		Description: "popstate event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onprogress",
+		Description: "progress event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onratechange",
+		Description: "ratechange event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onrejectionhandled",
+		Description: "rejectionhandled event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onreset",
+		Description: "reset event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onresize",
+		Description: "resize event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onscroll",
+		Description: "scroll event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onscrollend",
+		Description: "scrollend event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onsecuritypolicyviolation",
+		Description: "securitypolicyviolation event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onseeked",
+		Description: "seeked event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onseeking",
+		Description: "seeking event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onselect",
+		Description: "select event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onslotchange",
+		Description: "slotchange event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onstalled",
+		Description: "stalled event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onstorage",
+		Description: "storage event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onsubmit",
+		Description: "submit event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onsuspend",
+		Description: "suspend event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ontimeupdate",
+		Description: "timeupdate event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "ontoggle",
+		Description: "toggle event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onunhandledrejection",
+		Description: "unhandledrejection event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onunload",
+		Description: "unload event handler for Window object",
+		Event:       true,
+		Elements:    []string{"body"},
+	},
+	{
+		Name:        "onvolumechange",
+		Description: "volumechange event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onwaiting",
+		Description: "waiting event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "onwheel",
+		Description: "wheel event handler",
+		Event:       true,
+		Global:      true,
+	},
+	{
+		Name:        "open",
+		Description: "Whether the element is open",
+		Boolean:     true,
+		Elements:    []string{"details", "dialog"},
+	},
+	{
+		Name:        "optimum",
+		Description: "Optimum value in gauge",
+		Elements:    []string{"meter"},
+	},
+	{
+		Name:        "pattern",
+		Description: "Pattern to be matched by the form control's value",
+		Elements:    []string{"input"},
+	},
+	{
+		Name:        "ping",
+		Description: "URLs to ping",
+		URL:         true,
+		Elements:    []string{"a", "area"},
+	},
+	{
+		Name:        "placeholder",
+		Description: "User-visible label to be placed within the form control",
+		Elements:    []string{"input", "textarea"},
+	},
+	{
+		Name:        "playsinline",
+		Description: "Encourage the user agent to display video content within the element's playback area",
+		Boolean:     true,
+		Elements:    []string{"video"},
+	},
+	{
+		Name:        "popover",
+		Description: "Makes the element a popover element",
+		Global:      true,
+		Keywords:    []string{"auto", "manual", "hint"},
+	},
+	{
+		Name:        "popovertarget",
+		Description: "Targets a popover element to toggle, show, or hide",
+		Elements:    []string{"button", "input"},
+	},
+	{
+		Name:        "popovertargetaction",
+		Description: "Indicates whether a targeted popover element is to be toggled, shown, or hidden",
+		Elements:    []string{"button", "input"},
+		Keywords:    []string{"toggle", "show", "hide"},
+	},
+	{
+		Name:        "poster",
+		Description: "Poster frame to show prior to video playback",
+		URL:         true,
+		Elements:    []string{"video"},
+	},
+	{
+		Name:        "preload",
+		Description: "Hints how much buffering the media resource will likely need",
+		Elements:    []string{"audio", "video"},
+		Keywords:    []string{"none", "metadata", "auto"},
+	},
+	{
+		Name:        "readonly",
+		Description: "Whether to allow the value to be edited by the user",
+		Boolean:     true,
+		Elements:    []string{"input", "textarea"},
+	},
+	{
+		Name:        "referrerpolicy",
+		Description: "Referrer policy for fetches initiated by the element",
+		Elements:    []string{"a", "area", "iframe", "img", "link", "script"},
+		Keywords:    []string{"no-referrer", "no-referrer-when-downgrade", "same-origin", "origin", "strict-origin", "origin-when-cross-origin", "strict-origin-when-cross-origin", "unsafe-url"},
+	},
+	{
+		Name:        "rel",
+		Description: "Relationship between the location in the document containing the hyperlink and the destination resource",
+		Elements:    []string{"a", "area", "form", "link"},
+		Keywords:    []string{"alternate", "author", "bookmark", "canonical", "dns-prefetch", "expect", "external", "help", "icon", "license", "manifest", "me", "modulepreload", "next", "nofollow", "noopener", "noreferrer", "opener", "pingback", "preconnect", "prefetch", "preload", "prev", "privacy-policy", "search", "stylesheet", "tag", "terms-of-service"},
+		TokenList:   true,
+	},
+	{
+		Name:        "required",
+		Description: "Whether the control is required for form submission",
+		Boolean:     true,
+		Elements:    []string{"input", "select", "textarea"},
+	},
+	{
+		Name:        "reversed",
+		Description: "Number the list backwards",
+		Boolean:     true,
+		Elements:    []string{"ol"},
+	},
+	{
+		Name:        "rows",
+		Description: "Number of lines to show",
+		Elements:    []string{"textarea"},
+	},
+	{
+		Name:        "rowspan",
+		Description: "Number of rows that the cell is to span",
+		Elements:    []string{"td", "th"},
+	},
+	{
+		Name:        "sandbox",
+		Description: "Security rules for nested content",
+		Elements:    []string{"iframe"},
+		Keywords:    []string{"allow-downloads", "allow-forms", "allow-modals", "allow-orientation-lock", "allow-pointer-lock", "allow-popups", "allow-popups-to-escape-sandbox", "allow-presentation", "allow-same-origin", "allow-scripts", "allow-top-navigation", "allow-top-navigation-by-user-activation", "allow-top-navigation-to-custom-protocols"},
+		TokenList:   true,
+	},
+	{
+		Name:        "scope",
+		Description: "Specifies which cells the header cell applies to",
+		Elements:    []string{"th"},
+		Keywords:    []string{"row", "col", "rowgroup", "colgroup"},
+	},
+	{
+		Name:        "selected",
+		Description: "Whether the option is selected by default",
+		Boolean:     true,
+		Elements:    []string{"option"},
+	},
+	{
+		Name:        "shape",
+		Description: "The kind of shape to be created in an image map",
+		Elements:    []string{"area"},
+		Keywords:    []string{"circle", "default", "poly", "rect"},
+	},
+	{
+		Name:        "size",
+		Description: "Size of the control",
+		Elements:    []string{"input", "select"},
+	},
+	{
+		Name:        "sizes",
+		Description: "Image sizes for different page layouts",
+		Elements:    []string{"img", "link", "source"},
+	},
+	{
+		Name:        "slot",
+		Description: "The element's desired slot",
+		Global:      true,
+	},
+	{
+		Name:        "span",
+		Description: "Number of columns spanned by the element",
+		Elements:    []string{"col", "colgroup"},
+	},
+	{
+		Name:        "spellcheck",
+		Description: "Whether the element is to have its spelling and grammar checked",
+		Global:      true,
+		Keywords:    []string{"true", "false"},
+	},
+	{
+		Name:        "src",
+		Description: "Address of the resource",
+		URL:         true,
+		Elements:    []string{"audio", "embed", "iframe", "img", "input", "script", "source", "track", "video"},
+	},
+	{
+		Name:        "srcdoc",
+		Description: "A document to render in the iframe",
+		Elements:    []string{"iframe"},
+	},
+	{
+		Name:        "srclang",
+		Description: "Language of the text track",
+		Elements:    []string{"track"},
+	},
+	{
+		Name:        "srcset",
+		Description: "Images to use in different situations, e.g., high-resolution displays, small monitors, etc.",
+		URL:         true,
+		Elements:    []string{"img", "source"},
+	},
+	{
+		Name:        "start",
+		Description: "Starting value of the list",
+		Elements:    []string{"ol"},
+	},
+	{
+		Name:        "step",
+		Description: "Granularity to be matched by the form control's value",
+		Elements:    []string{"input"},
+	},
+	{
+		Name:        "style",
+		Description: "Presentational and formatting instructions",
+		Global:      true,
+	},
+	{
+		Name:        "tabindex",
+		Description: "Whether the element is focusable and sequentially focusable, and the relative order of the element for the purposes of sequential focus navigation",
+		Global:      true,
+	},
+	{
+		Name:        "target",
+		Description: "Navigable for hyperlink navigation or form submission",
+		Elements:    []string{"a", "area", "base", "form"},
+		Keywords:    []string{"_blank", "_self", "_parent", "_top"},
+	},
+	{
+		Name:        "title",
+		Description: "Advisory information for the element",
+		Global:      true,
+	},
+	{
+		Name:        "translate",
+		Description: "Whether the element is to be translated when the page is localized",
+		Global:      true,
+		Keywords:    []string{"yes", "no"},
+	},
+	{
+		Name:        "type",
+		Description: "Type of the element",
+		Elements:    []string{"a", "button", "embed", "input", "link", "object", "ol", "script", "source"},
+		Keywords:    []string{"hidden", "text", "search", "tel", "url", "email", "password", "date", "month", "week", "time", "datetime-local", "number", "range", "color", "checkbox", "radio", "file", "submit", "image", "reset", "button", "module", "importmap", "speculationrules", "1", "a", "A", "i", "I"},
+	},
+	{
+		Name:        "usemap",
+		Description: "Name of image map to use",
+		Elements:    []string{"img"},
+	},
+	{
+		Name:        "value",
+		Description: "Value of the element",
+		Elements:    []string{"button", "data", "input", "li", "meter", "option", "output", "progress"},
+	},
+	{
+		Name:        "width",
+		Description: "Horizontal dimension",
+		Elements:    []string{"canvas", "embed", "iframe", "img", "input", "object", "source", "video"},
+	},
+	{
+		Name:        "wrap",
+		Description: "How the value of the form control is to be wrapped for form submission",
+		Elements:    []string{"textarea"},
+		Keywords:    []string{"soft", "hard"},
+	},
+}
@@ -0,0 +1,1278 @@
+// Code generated by internal/specgen from pkg/spec/svg.json. DO NOT EDIT.
+
+package svg
+
+import (
+	"github.com/jeffswenson/sanity/internal/attrvalue"
+	"github.com/jeffswenson/sanity/pkg/html"
+)
+
+// AttributeName constructs an html.Node for the `attributeName` attribute.
+//
+// Name of the attribute to animate.
+func AttributeName(value string) html.Node {
+	return html.NewAttribute("attributeName", value)
+}
+
+// BaseFrequency constructs an html.Node for the `baseFrequency` attribute.
+//
+// Base frequency of the noise of feTurbulence.
+func BaseFrequency(value string) html.Node {
+	return html.NewAttribute("baseFrequency", value)
+}
+
+// Begin constructs an html.Node for the `begin` attribute.
+//
+// Time at which an animation starts.
+func Begin(value string) html.Node {
+	return html.NewAttribute("begin", value)
+}
+
+// By constructs an html.Node for the `by` attribute.
+//
+// Relative offset of an animation.
+func By(value string) html.Node {
+	return html.NewAttribute("by", value)
+}
+
+// CalcMode constructs an html.Node for the `calcMode` attribute.
+//
+// Interpolation mode of an animation.
+func CalcMode(value string) html.Node {
+	return html.NewAttribute("calcMode", value)
+}
+
+// ClipPathAttr constructs an html.Node for the `clip-path` attribute.
+//
+// Clipping path to apply, like url(#clip).
+func ClipPathAttr(value string) html.Node {
+	return html.NewAttribute("clip-path", value)
+}
+
+// ClipRule constructs an html.Node for the `clip-rule` attribute.
+//
+// Fill rule of a clipping path.
+func ClipRule(value string) html.Node {
+	return html.NewAttribute("clip-rule", value)
+}
+
+// ClipPathUnits constructs an html.Node for the `clipPathUnits` attribute.
+//
+// Coordinate system of the contents of a clipPath.
+func ClipPathUnits(value string) html.Node {
+	return html.NewAttribute("clipPathUnits", value)
+}
+
+// Color constructs an html.Node for the `color` attribute.
+//
+// Value of currentcolor.
+func Color(value string) html.Node {
+	return html.NewAttribute("color", value)
+}
+
+// Cursor constructs an html.Node for the `cursor` attribute.
+//
+// Mouse cursor shown over the element.
+func Cursor(value string) html.Node {
+	return html.NewAttribute("cursor", value)
+}
+
+// CX constructs an html.Node for the `cx` attribute.
+//
+// X coordinate of the center.
+//
+// Use CXInt or CXFloat to construct the attribute from an int or a float64.
+func CX(value string) html.Node {
+	return html.NewAttribute("cx", value)
+}
+
+// CXInt constructs the `cx` attribute from an int.
+func CXInt(value int) html.Node {
+	return attrvalue.Int("cx", value)
+}
+
+// CXFloat constructs the `cx` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func CXFloat(value float64) html.Node {
+	return attrvalue.Float("cx", value)
+}
+
+// CY constructs an html.Node for the `cy` attribute.
+//
+// Y coordinate of the center.
+//
+// Use CYInt or CYFloat to construct the attribute from an int or a float64.
+func CY(value string) html.Node {
+	return html.NewAttribute("cy", value)
+}
+
+// CYInt constructs the `cy` attribute from an int.
+func CYInt(value int) html.Node {
+	return attrvalue.Int("cy", value)
+}
+
+// CYFloat constructs the `cy` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func CYFloat(value float64) html.Node {
+	return attrvalue.Float("cy", value)
+}
+
+// D constructs an html.Node for the `d` attribute.
+//
+// Path data.
+func D(value string) html.Node {
+	return html.NewAttribute("d", value)
+}
+
+// Display constructs an html.Node for the `display` attribute.
+//
+// Whether the element is rendered.
+func Display(value string) html.Node {
+	return html.NewAttribute("display", value)
+}
+
+// DominantBaseline constructs an html.Node for the `dominant-baseline` attribute.
+//
+// Baseline used to align text.
+func DominantBaseline(value string) html.Node {
+	return html.NewAttribute("dominant-baseline", value)
+}
+
+// Dur constructs an html.Node for the `dur` attribute.
+//
+// Duration of an animation.
+func Dur(value string) html.Node {
+	return html.NewAttribute("dur", value)
+}
+
+// DX constructs an html.Node for the `dx` attribute.
+//
+// Horizontal shift.
+//
+// Use DXInt or DXFloat to construct the attribute from an int or a float64.
+func DX(value string) html.Node {
+	return html.NewAttribute("dx", value)
+}
+
+// DXInt constructs the `dx` attribute from an int.
+func DXInt(value int) html.Node {
+	return attrvalue.Int("dx", value)
+}
+
+// DXFloat constructs the `dx` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func DXFloat(value float64) html.Node {
+	return attrvalue.Float("dx", value)
+}
+
+// DY constructs an html.Node for the `dy` attribute.
+//
+// Vertical shift.
+//
+// Use DYInt or DYFloat to construct the attribute from an int or a float64.
+func DY(value string) html.Node {
+	return html.NewAttribute("dy", value)
+}
+
+// DYInt constructs the `dy` attribute from an int.
+func DYInt(value int) html.Node {
+	return attrvalue.Int("dy", value)
+}
+
+// DYFloat constructs the `dy` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func DYFloat(value float64) html.Node {
+	return attrvalue.Float("dy", value)
+}
+
+// End constructs an html.Node for the `end` attribute.
+//
+// Time at which an animation ends.
+func End(value string) html.Node {
+	return html.NewAttribute("end", value)
+}
+
+// Fill constructs an html.Node for the `fill` attribute.
+//
+// Paint used to fill the shape, or the state after an animation ends.
+func Fill(value string) html.Node {
+	return html.NewAttribute("fill", value)
+}
+
+// FillOpacity constructs an html.Node for the `fill-opacity` attribute.
+//
+// Opacity of the fill.
+//
+// Use FillOpacityInt or FillOpacityFloat to construct the attribute from an int
+// or a float64.
+func FillOpacity(value string) html.Node {
+	return html.NewAttribute("fill-opacity", value)
+}
+
+// FillOpacityInt constructs the `fill-opacity` attribute from an int.
+func FillOpacityInt(value int) html.Node {
+	return attrvalue.Int("fill-opacity", value)
+}
+
+// FillOpacityFloat constructs the `fill-opacity` attribute from a float64. NaN
+// and infinities are not valid numbers and render as nothing.
+func FillOpacityFloat(value float64) html.Node {
+	return attrvalue.Float("fill-opacity", value)
+}
+
+// FillRule constructs an html.Node for the `fill-rule` attribute.
+//
+// Rule that determines the inside of a shape.
+func FillRule(value string) html.Node {
+	return html.NewAttribute("fill-rule", value)
+}
+
+// FilterAttr constructs an html.Node for the `filter` attribute.
+//
+// Filter effect to apply, like url(#blur).
+func FilterAttr(value string) html.Node {
+	return html.NewAttribute("filter", value)
+}
+
+// FilterUnits constructs an html.Node for the `filterUnits` attribute.
+//
+// Coordinate system of the filter region.
+func FilterUnits(value string) html.Node {
+	return html.NewAttribute("filterUnits", value)
+}
+
+// FloodColor constructs an html.Node for the `flood-color` attribute.
+//
+// Color of feFlood and feDropShadow.
+func FloodColor(value string) html.Node {
+	return html.NewAttribute("flood-color", value)
+}
+
+// FloodOpacity constructs an html.Node for the `flood-opacity` attribute.
+//
+// Opacity of feFlood and feDropShadow.
+//
+// Use FloodOpacityInt or FloodOpacityFloat to construct the attribute from an
+// int or a float64.
+func FloodOpacity(value string) html.Node {
+	return html.NewAttribute("flood-opacity", value)
+}
+
+// FloodOpacityInt constructs the `flood-opacity` attribute from an int.
+func FloodOpacityInt(value int) html.Node {
+	return attrvalue.Int("flood-opacity", value)
+}
+
+// FloodOpacityFloat constructs the `flood-opacity` attribute from a float64.
+// NaN and infinities are not valid numbers and render as nothing.
+func FloodOpacityFloat(value float64) html.Node {
+	return attrvalue.Float("flood-opacity", value)
+}
+
+// FontFamily constructs an html.Node for the `font-family` attribute.
+//
+// Font family of text.
+func FontFamily(value string) html.Node {
+	return html.NewAttribute("font-family", value)
+}
+
+// FontSize constructs an html.Node for the `font-size` attribute.
+//
+// Font size of text.
+func FontSize(value string) html.Node {
+	return html.NewAttribute("font-size", value)
+}
+
+// FontStyle constructs an html.Node for the `font-style` attribute.
+//
+// Font style of text.
+func FontStyle(value string) html.Node {
+	return html.NewAttribute("font-style", value)
+}
+
+// FontWeight constructs an html.Node for the `font-weight` attribute.
+//
+// Font weight of text.
+func FontWeight(value string) html.Node {
+	return html.NewAttribute("font-weight", value)
+}
+
+// FR constructs an html.Node for the `fr` attribute.
+//
+// Radius of the focal point of a radial gradient.
+//
+// Use FRInt or FRFloat to construct the attribute from an int or a float64.
+func FR(value string) html.Node {
+	return html.NewAttribute("fr", value)
+}
+
+// FRInt constructs the `fr` attribute from an int.
+func FRInt(value int) html.Node {
+	return attrvalue.Int("fr", value)
+}
+
+// FRFloat constructs the `fr` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func FRFloat(value float64) html.Node {
+	return attrvalue.Float("fr", value)
+}
+
+// From constructs an html.Node for the `from` attribute.
+//
+// Starting value of an animation.
+func From(value string) html.Node {
+	return html.NewAttribute("from", value)
+}
+
+// FX constructs an html.Node for the `fx` attribute.
+//
+// X coordinate of the focal point of a radial gradient.
+//
+// Use FXInt or FXFloat to construct the attribute from an int or a float64.
+func FX(value string) html.Node {
+	return html.NewAttribute("fx", value)
+}
+
+// FXInt constructs the `fx` attribute from an int.
+func FXInt(value int) html.Node {
+	return attrvalue.Int("fx", value)
+}
+
+// FXFloat constructs the `fx` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func FXFloat(value float64) html.Node {
+	return attrvalue.Float("fx", value)
+}
+
+// FY constructs an html.Node for the `fy` attribute.
+//
+// Y coordinate of the focal point of a radial gradient.
+//
+// Use FYInt or FYFloat to construct the attribute from an int or a float64.
+func FY(value string) html.Node {
+	return html.NewAttribute("fy", value)
+}
+
+// FYInt constructs the `fy` attribute from an int.
+func FYInt(value int) html.Node {
+	return attrvalue.Int("fy", value)
+}
+
+// FYFloat constructs the `fy` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func FYFloat(value float64) html.Node {
+	return attrvalue.Float("fy", value)
+}
+
+// GradientTransform constructs an html.Node for the `gradientTransform` attribute.
+//
+// Transformation of a gradient.
+func GradientTransform(value string) html.Node {
+	return html.NewAttribute("gradientTransform", value)
+}
+
+// GradientUnits constructs an html.Node for the `gradientUnits` attribute.
+//
+// Coordinate system of a gradient.
+func GradientUnits(value string) html.Node {
+	return html.NewAttribute("gradientUnits", value)
+}
+
+// Height constructs an html.Node for the `height` attribute.
+//
+// Height.
+//
+// Use HeightInt or HeightFloat to construct the attribute from an int or a
+// float64.
+func Height(value string) html.Node {
+	return html.NewAttribute("height", value)
+}
+
+// HeightInt constructs the `height` attribute from an int.
+func HeightInt(value int) html.Node {
+	return attrvalue.Int("height", value)
+}
+
+// HeightFloat constructs the `height` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func HeightFloat(value float64) html.Node {
+	return attrvalue.Float("height", value)
+}
+
+// HRef constructs an html.Node for the `href` attribute.
+//
+// URL of the referenced element or resource.
+func HRef(value string) html.Node {
+	return html.NewAttribute("href", value)
+}
+
+// In constructs an html.Node for the `in` attribute.
+//
+// Input of a filter primitive.
+func In(value string) html.Node {
+	return html.NewAttribute("in", value)
+}
+
+// In2 constructs an html.Node for the `in2` attribute.
+//
+// Second input of a filter primitive.
+func In2(value string) html.Node {
+	return html.NewAttribute("in2", value)
+}
+
+// KeyTimes constructs an html.Node for the `keyTimes` attribute.
+//
+// Times of the values of an animation.
+func KeyTimes(value string) html.Node {
+	return html.NewAttribute("keyTimes", value)
+}
+
+// LengthAdjust constructs an html.Node for the `lengthAdjust` attribute.
+//
+// How text is stretched to textLength.
+func LengthAdjust(value string) html.Node {
+	return html.NewAttribute("lengthAdjust", value)
+}
+
+// LetterSpacing constructs an html.Node for the `letter-spacing` attribute.
+//
+// Space between letters.
+func LetterSpacing(value string) html.Node {
+	return html.NewAttribute("letter-spacing", value)
+}
+
+// MarkerEnd constructs an html.Node for the `marker-end` attribute.
+//
+// Marker drawn at the last vertex.
+func MarkerEnd(value string) html.Node {
+	return html.NewAttribute("marker-end", value)
+}
+
+// MarkerMid constructs an html.Node for the `marker-mid` attribute.
+//
+// Marker drawn at the middle vertices.
+func MarkerMid(value string) html.Node {
+	return html.NewAttribute("marker-mid", value)
+}
+
+// MarkerStart constructs an html.Node for the `marker-start` attribute.
+//
+// Marker drawn at the first vertex.
+func MarkerStart(value string) html.Node {
+	return html.NewAttribute("marker-start", value)
+}
+
+// MarkerHeight constructs an html.Node for the `markerHeight` attribute.
+//
+// Height of the viewport of a marker.
+//
+// Use MarkerHeightInt or MarkerHeightFloat to construct the attribute from an
+// int or a float64.
+func MarkerHeight(value string) html.Node {
+	return html.NewAttribute("markerHeight", value)
+}
+
+// MarkerHeightInt constructs the `markerHeight` attribute from an int.
+func MarkerHeightInt(value int) html.Node {
+	return attrvalue.Int("markerHeight", value)
+}
+
+// MarkerHeightFloat constructs the `markerHeight` attribute from a float64. NaN
+// and infinities are not valid numbers and render as nothing.
+func MarkerHeightFloat(value float64) html.Node {
+	return attrvalue.Float("markerHeight", value)
+}
+
+// MarkerUnits constructs an html.Node for the `markerUnits` attribute.
+//
+// Coordinate system of a marker.
+func MarkerUnits(value string) html.Node {
+	return html.NewAttribute("markerUnits", value)
+}
+
+// MarkerWidth constructs an html.Node for the `markerWidth` attribute.
+//
+// Width of the viewport of a marker.
+//
+// Use MarkerWidthInt or MarkerWidthFloat to construct the attribute from an int
+// or a float64.
+func MarkerWidth(value string) html.Node {
+	return html.NewAttribute("markerWidth", value)
+}
+
+// MarkerWidthInt constructs the `markerWidth` attribute from an int.
+func MarkerWidthInt(value int) html.Node {
+	return attrvalue.Int("markerWidth", value)
+}
+
+// MarkerWidthFloat constructs the `markerWidth` attribute from a float64. NaN
+// and infinities are not valid numbers and render as nothing.
+func MarkerWidthFloat(value float64) html.Node {
+	return attrvalue.Float("markerWidth", value)
+}
+
+// MaskAttr constructs an html.Node for the `mask` attribute.
+//
+// Mask to apply, like url(#mask).
+func MaskAttr(value string) html.Node {
+	return html.NewAttribute("mask", value)
+}
+
+// MaskContentUnits constructs an html.Node for the `maskContentUnits` attribute.
+//
+// Coordinate system of the contents of a mask.
+func MaskContentUnits(value string) html.Node {
+	return html.NewAttribute("maskContentUnits", value)
+}
+
+// MaskUnits constructs an html.Node for the `maskUnits` attribute.
+//
+// Coordinate system of the mask region.
+func MaskUnits(value string) html.Node {
+	return html.NewAttribute("maskUnits", value)
+}
+
+// Mode constructs an html.Node for the `mode` attribute.
+//
+// Blend mode of feBlend.
+func Mode(value string) html.Node {
+	return html.NewAttribute("mode", value)
+}
+
+// NumOctaves constructs an html.Node for the `numOctaves` attribute.
+//
+// Number of octaves of feTurbulence.
+//
+// Use NumOctavesInt or NumOctavesFloat to construct the attribute from an int
+// or a float64.
+func NumOctaves(value string) html.Node {
+	return html.NewAttribute("numOctaves", value)
+}
+
+// NumOctavesInt constructs the `numOctaves` attribute from an int.
+func NumOctavesInt(value int) html.Node {
+	return attrvalue.Int("numOctaves", value)
+}
+
+// NumOctavesFloat constructs the `numOctaves` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func NumOctavesFloat(value float64) html.Node {
+	return attrvalue.Float("numOctaves", value)
+}
+
+// Offset constructs an html.Node for the `offset` attribute.
+//
+// Position of a gradient stop, like 0.5 or 50%.
+func Offset(value string) html.Node {
+	return html.NewAttribute("offset", value)
+}
+
+// Opacity constructs an html.Node for the `opacity` attribute.
+//
+// Opacity of the element.
+//
+// Use OpacityInt or OpacityFloat to construct the attribute from an int or a
+// float64.
+func Opacity(value string) html.Node {
+	return html.NewAttribute("opacity", value)
+}
+
+// OpacityInt constructs the `opacity` attribute from an int.
+func OpacityInt(value int) html.Node {
+	return attrvalue.Int("opacity", value)
+}
+
+// OpacityFloat constructs the `opacity` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func OpacityFloat(value float64) html.Node {
+	return attrvalue.Float("opacity", value)
+}
+
+// Operator constructs an html.Node for the `operator` attribute.
+//
+// Operator of feComposite or feMorphology.
+func Operator(value string) html.Node {
+	return html.NewAttribute("operator", value)
+}
+
+// Orient constructs an html.Node for the `orient` attribute.
+//
+// Rotation of a marker.
+func Orient(value string) html.Node {
+	return html.NewAttribute("orient", value)
+}
+
+// Overflow constructs an html.Node for the `overflow` attribute.
+//
+// Whether content outside of the viewport is clipped.
+func Overflow(value string) html.Node {
+	return html.NewAttribute("overflow", value)
+}
+
+// PathAttr constructs an html.Node for the `path` attribute.
+//
+// Motion path of animateMotion.
+func PathAttr(value string) html.Node {
+	return html.NewAttribute("path", value)
+}
+
+// PathLength constructs an html.Node for the `pathLength` attribute.
+//
+// Author's computation of the total length of the path.
+//
+// Use PathLengthInt or PathLengthFloat to construct the attribute from an int
+// or a float64.
+func PathLength(value string) html.Node {
+	return html.NewAttribute("pathLength", value)
+}
+
+// PathLengthInt constructs the `pathLength` attribute from an int.
+func PathLengthInt(value int) html.Node {
+	return attrvalue.Int("pathLength", value)
+}
+
+// PathLengthFloat constructs the `pathLength` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func PathLengthFloat(value float64) html.Node {
+	return attrvalue.Float("pathLength", value)
+}
+
+// PatternContentUnits constructs an html.Node for the `patternContentUnits` attribute.
+//
+// Coordinate system of the contents of a pattern.
+func PatternContentUnits(value string) html.Node {
+	return html.NewAttribute("patternContentUnits", value)
+}
+
+// PatternTransform constructs an html.Node for the `patternTransform` attribute.
+//
+// Transformation of a pattern.
+func PatternTransform(value string) html.Node {
+	return html.NewAttribute("patternTransform", value)
+}
+
+// PatternUnits constructs an html.Node for the `patternUnits` attribute.
+//
+// Coordinate system of a pattern.
+func PatternUnits(value string) html.Node {
+	return html.NewAttribute("patternUnits", value)
+}
+
+// PointerEvents constructs an html.Node for the `pointer-events` attribute.
+//
+// When the element is the target of pointer events.
+func PointerEvents(value string) html.Node {
+	return html.NewAttribute("pointer-events", value)
+}
+
+// Points constructs an html.Node for the `points` attribute.
+//
+// Points of a polygon or polyline.
+func Points(value string) html.Node {
+	return html.NewAttribute("points", value)
+}
+
+// PreserveAspectRatio constructs an html.Node for the `preserveAspectRatio` attribute.
+//
+// How the viewBox is fitted to the viewport.
+func PreserveAspectRatio(value string) html.Node {
+	return html.NewAttribute("preserveAspectRatio", value)
+}
+
+// PrimitiveUnits constructs an html.Node for the `primitiveUnits` attribute.
+//
+// Coordinate system of filter primitives.
+func PrimitiveUnits(value string) html.Node {
+	return html.NewAttribute("primitiveUnits", value)
+}
+
+// R constructs an html.Node for the `r` attribute.
+//
+// Radius.
+//
+// Use RInt or RFloat to construct the attribute from an int or a float64.
+func R(value string) html.Node {
+	return html.NewAttribute("r", value)
+}
+
+// RInt constructs the `r` attribute from an int.
+func RInt(value int) html.Node {
+	return attrvalue.Int("r", value)
+}
+
+// RFloat constructs the `r` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func RFloat(value float64) html.Node {
+	return attrvalue.Float("r", value)
+}
+
+// RefX constructs an html.Node for the `refX` attribute.
+//
+// X coordinate of the reference point of a marker or symbol.
+//
+// Use RefXInt or RefXFloat to construct the attribute from an int or a float64.
+func RefX(value string) html.Node {
+	return html.NewAttribute("refX", value)
+}
+
+// RefXInt constructs the `refX` attribute from an int.
+func RefXInt(value int) html.Node {
+	return attrvalue.Int("refX", value)
+}
+
+// RefXFloat constructs the `refX` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func RefXFloat(value float64) html.Node {
+	return attrvalue.Float("refX", value)
+}
+
+// RefY constructs an html.Node for the `refY` attribute.
+//
+// Y coordinate of the reference point of a marker or symbol.
+//
+// Use RefYInt or RefYFloat to construct the attribute from an int or a float64.
+func RefY(value string) html.Node {
+	return html.NewAttribute("refY", value)
+}
+
+// RefYInt constructs the `refY` attribute from an int.
+func RefYInt(value int) html.Node {
+	return attrvalue.Int("refY", value)
+}
+
+// RefYFloat constructs the `refY` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func RefYFloat(value float64) html.Node {
+	return attrvalue.Float("refY", value)
+}
+
+// RepeatCount constructs an html.Node for the `repeatCount` attribute.
+//
+// Number of times an animation repeats.
+func RepeatCount(value string) html.Node {
+	return html.NewAttribute("repeatCount", value)
+}
+
+// Result constructs an html.Node for the `result` attribute.
+//
+// Name of the output of a filter primitive.
+func Result(value string) html.Node {
+	return html.NewAttribute("result", value)
+}
+
+// Rotate constructs an html.Node for the `rotate` attribute.
+//
+// Rotation of glyphs or of an element along a motion path.
+func Rotate(value string) html.Node {
+	return html.NewAttribute("rotate", value)
+}
+
+// RX constructs an html.Node for the `rx` attribute.
+//
+// Horizontal radius.
+//
+// Use RXInt or RXFloat to construct the attribute from an int or a float64.
+func RX(value string) html.Node {
+	return html.NewAttribute("rx", value)
+}
+
+// RXInt constructs the `rx` attribute from an int.
+func RXInt(value int) html.Node {
+	return attrvalue.Int("rx", value)
+}
+
+// RXFloat constructs the `rx` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func RXFloat(value float64) html.Node {
+	return attrvalue.Float("rx", value)
+}
+
+// RY constructs an html.Node for the `ry` attribute.
+//
+// Vertical radius.
+//
+// Use RYInt or RYFloat to construct the attribute from an int or a float64.
+func RY(value string) html.Node {
+	return html.NewAttribute("ry", value)
+}
+
+// RYInt constructs the `ry` attribute from an int.
+func RYInt(value int) html.Node {
+	return attrvalue.Int("ry", value)
+}
+
+// RYFloat constructs the `ry` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func RYFloat(value float64) html.Node {
+	return attrvalue.Float("ry", value)
+}
+
+// Scale constructs an html.Node for the `scale` attribute.
+//
+// Scale factor of feDisplacementMap.
+//
+// Use ScaleInt or ScaleFloat to construct the attribute from an int or a
+// float64.
+func Scale(value string) html.Node {
+	return html.NewAttribute("scale", value)
+}
+
+// ScaleInt constructs the `scale` attribute from an int.
+func ScaleInt(value int) html.Node {
+	return attrvalue.Int("scale", value)
+}
+
+// ScaleFloat constructs the `scale` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func ScaleFloat(value float64) html.Node {
+	return attrvalue.Float("scale", value)
+}
+
+// Seed constructs an html.Node for the `seed` attribute.
+//
+// Seed of the random numbers of feTurbulence.
+//
+// Use SeedInt or SeedFloat to construct the attribute from an int or a float64.
+func Seed(value string) html.Node {
+	return html.NewAttribute("seed", value)
+}
+
+// SeedInt constructs the `seed` attribute from an int.
+func SeedInt(value int) html.Node {
+	return attrvalue.Int("seed", value)
+}
+
+// SeedFloat constructs the `seed` attribute from a float64. NaN and infinities
+// are not valid numbers and render as nothing.
+func SeedFloat(value float64) html.Node {
+	return attrvalue.Float("seed", value)
+}
+
+// ShapeRendering constructs an html.Node for the `shape-rendering` attribute.
+//
+// Rendering quality of shapes.
+func ShapeRendering(value string) html.Node {
+	return html.NewAttribute("shape-rendering", value)
+}
+
+// SpreadMethod constructs an html.Node for the `spreadMethod` attribute.
+//
+// How a gradient is drawn outside of its bounds.
+func SpreadMethod(value string) html.Node {
+	return html.NewAttribute("spreadMethod", value)
+}
+
+// StartOffset constructs an html.Node for the `startOffset` attribute.
+//
+// Offset of the start of a textPath.
+func StartOffset(value string) html.Node {
+	return html.NewAttribute("startOffset", value)
+}
+
+// StdDeviation constructs an html.Node for the `stdDeviation` attribute.
+//
+// Standard deviation of a blur.
+func StdDeviation(value string) html.Node {
+	return html.NewAttribute("stdDeviation", value)
+}
+
+// StopColor constructs an html.Node for the `stop-color` attribute.
+//
+// Color of a gradient stop.
+func StopColor(value string) html.Node {
+	return html.NewAttribute("stop-color", value)
+}
+
+// StopOpacity constructs an html.Node for the `stop-opacity` attribute.
+//
+// Opacity of a gradient stop.
+//
+// Use StopOpacityInt or StopOpacityFloat to construct the attribute from an int
+// or a float64.
+func StopOpacity(value string) html.Node {
+	return html.NewAttribute("stop-opacity", value)
+}
+
+// StopOpacityInt constructs the `stop-opacity` attribute from an int.
+func StopOpacityInt(value int) html.Node {
+	return attrvalue.Int("stop-opacity", value)
+}
+
+// StopOpacityFloat constructs the `stop-opacity` attribute from a float64. NaN
+// and infinities are not valid numbers and render as nothing.
+func StopOpacityFloat(value float64) html.Node {
+	return attrvalue.Float("stop-opacity", value)
+}
+
+// Stroke constructs an html.Node for the `stroke` attribute.
+//
+// Paint used to draw the outline of the shape.
+func Stroke(value string) html.Node {
+	return html.NewAttribute("stroke", value)
+}
+
+// StrokeDashArray constructs an html.Node for the `stroke-dasharray` attribute.
+//
+// Pattern of dashes and gaps of the outline.
+func StrokeDashArray(value string) html.Node {
+	return html.NewAttribute("stroke-dasharray", value)
+}
+
+// StrokeDashOffset constructs an html.Node for the `stroke-dashoffset` attribute.
+//
+// Offset of the dash pattern.
+//
+// Use StrokeDashOffsetInt or StrokeDashOffsetFloat to construct the attribute
+// from an int or a float64.
+func StrokeDashOffset(value string) html.Node {
+	return html.NewAttribute("stroke-dashoffset", value)
+}
+
+// StrokeDashOffsetInt constructs the `stroke-dashoffset` attribute from an int.
+func StrokeDashOffsetInt(value int) html.Node {
+	return attrvalue.Int("stroke-dashoffset", value)
+}
+
+// StrokeDashOffsetFloat constructs the `stroke-dashoffset` attribute from a
+// float64. NaN and infinities are not valid numbers and render as nothing.
+func StrokeDashOffsetFloat(value float64) html.Node {
+	return attrvalue.Float("stroke-dashoffset", value)
+}
+
+// StrokeLineCap constructs an html.Node for the `stroke-linecap` attribute.
+//
+// Shape of the ends of open paths.
+func StrokeLineCap(value string) html.Node {
+	return html.NewAttribute("stroke-linecap", value)
+}
+
+// StrokeLineJoin constructs an html.Node for the `stroke-linejoin` attribute.
+//
+// Shape of the corners of paths.
+func StrokeLineJoin(value string) html.Node {
+	return html.NewAttribute("stroke-linejoin", value)
+}
+
+// StrokeMiterLimit constructs an html.Node for the `stroke-miterlimit` attribute.
+//
+// Limit of the ratio of the miter length to the stroke width.
+//
+// Use StrokeMiterLimitInt or StrokeMiterLimitFloat to construct the attribute
+// from an int or a float64.
+func StrokeMiterLimit(value string) html.Node {
+	return html.NewAttribute("stroke-miterlimit", value)
+}
+
+// StrokeMiterLimitInt constructs the `stroke-miterlimit` attribute from an int.
+func StrokeMiterLimitInt(value int) html.Node {
+	return attrvalue.Int("stroke-miterlimit", value)
+}
+
+// StrokeMiterLimitFloat constructs the `stroke-miterlimit` attribute from a
+// float64. NaN and infinities are not valid numbers and render as nothing.
+func StrokeMiterLimitFloat(value float64) html.Node {
+	return attrvalue.Float("stroke-miterlimit", value)
+}
+
+// StrokeOpacity constructs an html.Node for the `stroke-opacity` attribute.
+//
+// Opacity of the outline.
+//
+// Use StrokeOpacityInt or StrokeOpacityFloat to construct the attribute from an
+// int or a float64.
+func StrokeOpacity(value string) html.Node {
+	return html.NewAttribute("stroke-opacity", value)
+}
+
+// StrokeOpacityInt constructs the `stroke-opacity` attribute from an int.
+func StrokeOpacityInt(value int) html.Node {
+	return attrvalue.Int("stroke-opacity", value)
+}
+
+// StrokeOpacityFloat constructs the `stroke-opacity` attribute from a float64.
+// NaN and infinities are not valid numbers and render as nothing.
+func StrokeOpacityFloat(value float64) html.Node {
+	return attrvalue.Float("stroke-opacity", value)
+}
+
+// StrokeWidth constructs an html.Node for the `stroke-width` attribute.
+//
+// Width of the outline.
+//
+// Use StrokeWidthInt or StrokeWidthFloat to construct the attribute from an int
+// or a float64.
+func StrokeWidth(value string) html.Node {
+	return html.NewAttribute("stroke-width", value)
+}
+
+// StrokeWidthInt constructs the `stroke-width` attribute from an int.
+func StrokeWidthInt(value int) html.Node {
+	return attrvalue.Int("stroke-width", value)
+}
+
+// StrokeWidthFloat constructs the `stroke-width` attribute from a float64. NaN
+// and infinities are not valid numbers and render as nothing.
+func StrokeWidthFloat(value float64) html.Node {
+	return attrvalue.Float("stroke-width", value)
+}
+
+// SystemLanguage constructs an html.Node for the `systemLanguage` attribute.
+//
+// Languages for which the element is rendered.
+func SystemLanguage(value string) html.Node {
+	return html.NewAttribute("systemLanguage", value)
+}
+
+// TextAnchor constructs an html.Node for the `text-anchor` attribute.
+//
+// Alignment of text relative to its position.
+func TextAnchor(value string) html.Node {
+	return html.NewAttribute("text-anchor", value)
+}
+
+// TextDecoration constructs an html.Node for the `text-decoration` attribute.
+//
+// Decoration of text, like underline.
+func TextDecoration(value string) html.Node {
+	return html.NewAttribute("text-decoration", value)
+}
+
+// TextLength constructs an html.Node for the `textLength` attribute.
+//
+// Length the text is stretched to.
+//
+// Use TextLengthInt or TextLengthFloat to construct the attribute from an int
+// or a float64.
+func TextLength(value string) html.Node {
+	return html.NewAttribute("textLength", value)
+}
+
+// TextLengthInt constructs the `textLength` attribute from an int.
+func TextLengthInt(value int) html.Node {
+	return attrvalue.Int("textLength", value)
+}
+
+// TextLengthFloat constructs the `textLength` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func TextLengthFloat(value float64) html.Node {
+	return attrvalue.Float("textLength", value)
+}
+
+// To constructs an html.Node for the `to` attribute.
+//
+// Ending value of an animation.
+func To(value string) html.Node {
+	return html.NewAttribute("to", value)
+}
+
+// Transform constructs an html.Node for the `transform` attribute.
+//
+// Transformation of the element.
+func Transform(value string) html.Node {
+	return html.NewAttribute("transform", value)
+}
+
+// Type constructs an html.Node for the `type` attribute.
+//
+// Type of a transform animation, a color matrix, a transfer function, or a
+// script.
+func Type(value string) html.Node {
+	return html.NewAttribute("type", value)
+}
+
+// Values constructs an html.Node for the `values` attribute.
+//
+// Values of an animation or a color matrix.
+func Values(value string) html.Node {
+	return html.NewAttribute("values", value)
+}
+
+// VectorEffect constructs an html.Node for the `vector-effect` attribute.
+//
+// Effect applied when drawing the element, like non-scaling-stroke.
+func VectorEffect(value string) html.Node {
+	return html.NewAttribute("vector-effect", value)
+}
+
+// ViewBox constructs an html.Node for the `viewBox` attribute.
+//
+// Coordinate system of the viewport, like 0 0 24 24.
+func ViewBox(value string) html.Node {
+	return html.NewAttribute("viewBox", value)
+}
+
+// Visibility constructs an html.Node for the `visibility` attribute.
+//
+// Whether the element is visible.
+func Visibility(value string) html.Node {
+	return html.NewAttribute("visibility", value)
+}
+
+// Width constructs an html.Node for the `width` attribute.
+//
+// Width.
+//
+// Use WidthInt or WidthFloat to construct the attribute from an int or a
+// float64.
+func Width(value string) html.Node {
+	return html.NewAttribute("width", value)
+}
+
+// WidthInt constructs the `width` attribute from an int.
+func WidthInt(value int) html.Node {
+	return attrvalue.Int("width", value)
+}
+
+// WidthFloat constructs the `width` attribute from a float64. NaN and
+// infinities are not valid numbers and render as nothing.
+func WidthFloat(value float64) html.Node {
+	return attrvalue.Float("width", value)
+}
+
+// WordSpacing constructs an html.Node for the `word-spacing` attribute.
+//
+// Space between words.
+func WordSpacing(value string) html.Node {
+	return html.NewAttribute("word-spacing", value)
+}
+
+// X constructs an html.Node for the `x` attribute.
+//
+// X coordinate.
+//
+// Use XInt or XFloat to construct the attribute from an int or a float64.
+func X(value string) html.Node {
+	return html.NewAttribute("x", value)
+}
+
+// XInt constructs the `x` attribute from an int.
+func XInt(value int) html.Node {
+	return attrvalue.Int("x", value)
+}
+
+// XFloat constructs the `x` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func XFloat(value float64) html.Node {
+	return attrvalue.Float("x", value)
+}
+
+// X1 constructs an html.Node for the `x1` attribute.
+//
+// X coordinate of the start.
+//
+// Use X1Int or X1Float to construct the attribute from an int or a float64.
+func X1(value string) html.Node {
+	return html.NewAttribute("x1", value)
+}
+
+// X1Int constructs the `x1` attribute from an int.
+func X1Int(value int) html.Node {
+	return attrvalue.Int("x1", value)
+}
+
+// X1Float constructs the `x1` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func X1Float(value float64) html.Node {
+	return attrvalue.Float("x1", value)
+}
+
+// X2 constructs an html.Node for the `x2` attribute.
+//
+// X coordinate of the end.
+//
+// Use X2Int or X2Float to construct the attribute from an int or a float64.
+func X2(value string) html.Node {
+	return html.NewAttribute("x2", value)
+}
+
+// X2Int constructs the `x2` attribute from an int.
+func X2Int(value int) html.Node {
+	return attrvalue.Int("x2", value)
+}
+
+// X2Float constructs the `x2` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func X2Float(value float64) html.Node {
+	return attrvalue.Float("x2", value)
+}
+
+// XLinkHRef constructs an html.Node for the `xlink:href` attribute.
+//
+// Deprecated form of href, for old browsers.
+func XLinkHRef(value string) html.Node {
+	return html.NewAttribute("xlink:href", value)
+}
+
+// XMLNS constructs an html.Node for the `xmlns` attribute.
+//
+// Namespace of the element, only needed in standalone SVG files.
+func XMLNS(value string) html.Node {
+	return html.NewAttribute("xmlns", value)
+}
+
+// XMLNSXLink constructs an html.Node for the `xmlns:xlink` attribute.
+//
+// Namespace of xlink attributes, only needed in standalone SVG files.
+func XMLNSXLink(value string) html.Node {
+	return html.NewAttribute("xmlns:xlink", value)
+}
+
+// Y constructs an html.Node for the `y` attribute.
+//
+// Y coordinate.
+//
+// Use YInt or YFloat to construct the attribute from an int or a float64.
+func Y(value string) html.Node {
+	return html.NewAttribute("y", value)
+}
+
+// YInt constructs the `y` attribute from an int.
+func YInt(value int) html.Node {
+	return attrvalue.Int("y", value)
+}
+
+// YFloat constructs the `y` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func YFloat(value float64) html.Node {
+	return attrvalue.Float("y", value)
+}
+
+// Y1 constructs an html.Node for the `y1` attribute.
+//
+// Y coordinate of the start.
+//
+// Use Y1Int or Y1Float to construct the attribute from an int or a float64.
+func Y1(value string) html.Node {
+	return html.NewAttribute("y1", value)
+}
+
+// Y1Int constructs the `y1` attribute from an int.
+func Y1Int(value int) html.Node {
+	return attrvalue.Int("y1", value)
+}
+
+// Y1Float constructs the `y1` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func Y1Float(value float64) html.Node {
+	return attrvalue.Float("y1", value)
+}
+
+// Y2 constructs an html.Node for the `y2` attribute.
+//
+// Y coordinate of the end.
+//
+// Use Y2Int or Y2Float to construct the attribute from an int or a float64.
+func Y2(value string) html.Node {
+	return html.NewAttribute("y2", value)
+}
+
+// Y2Int constructs the `y2` attribute from an int.
+func Y2Int(value int) html.Node {
+	return attrvalue.Int("y2", value)
+}
+
+// Y2Float constructs the `y2` attribute from a float64. NaN and infinities are
+// not valid numbers and render as nothing.
+func Y2Float(value float64) html.Node {
+	return attrvalue.Float("y2", value)
+}
@@ -0,0 +1,20 @@
+// Package svg contains constructors for the elements and attributes of SVG 2.
+// The constructors are generated from pkg/spec/svg.json.
+//
+// SVG elements are foreign elements, so they render as self-closing tags when
+// they have no content and their names keep their case, like
+// linearGradient and viewBox. Use pkg/attr for the attributes SVG shares with
+// HTML, like `id`, `class`, and `style`, and pkg/aria for ARIA attributes.
+// Numeric attributes take a string, like svg.Width("1em"), and have typed
+// variants, like svg.WidthInt(24) and svg.RFloat(10.5).
+//
+// Example Usage:
+//
+//	svg.SVG(
+//		svg.ViewBox("0 0 24 24"), svg.WidthInt(24), svg.HeightInt(24),
+//		svg.Circle(svg.CXInt(12), svg.CYInt(12), svg.RInt(10), svg.Fill("none"), svg.Stroke("currentColor")),
+//	)
+//
+// renders as
+// <svg viewBox="0 0 24 24" width="24" height="24"><circle cx="12" cy="12" r="10" fill="none" stroke="currentColor"/></svg>
+package svg
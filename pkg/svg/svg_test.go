@@ -0,0 +1,105 @@
+package svg
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	icon := SVG(
+		ViewBox("0 0 24 24"), WidthInt(24), HeightInt(24), attr.Class("icon"),
+		Defs(LinearGradient(attr.Id("fade"), X2Int(1),
+			Stop(Offset("0"), StopColor("#fff")),
+			Stop(Offset("1"), StopColor("#000"), StopOpacityFloat(0.5)),
+		)),
+		Circle(CXInt(12), CYInt(12), RFloat(10.5), Fill("url(#fade)")),
+		Path(D("M4 12h16"), Stroke("currentColor"), StrokeWidthInt(2), StrokeLineCap("round")),
+		Use(HRef("#dot"), XLinkHRef("#dot")),
+		Text(XInt(12), YInt(20), TextAnchor("middle"), html.InnerText("a < b")),
+	)
+	require.Equal(t, ``+
+		`<svg viewBox="0 0 24 24" width="24" height="24" class="icon">`+
+		`<defs><linearGradient id="fade" x2="1">`+
+		`<stop offset="0" stop-color="#fff"/>`+
+		`<stop offset="1" stop-color="#000" stop-opacity="0.5"/>`+
+		`</linearGradient></defs>`+
+		`<circle cx="12" cy="12" r="10.5" fill="url(#fade)"/>`+
+		`<path d="M4 12h16" stroke="currentColor" stroke-width="2" stroke-linecap="round"/>`+
+		`<use href="#dot" xlink:href="#dot"/>`+
+		`<text x="12" y="20" text-anchor="middle">a &lt; b</text>`+
+		`</svg>`,
+		icon.String())
+
+	var buffer bytes.Buffer
+	require.NoError(t, tag.P(icon).RenderTo(&buffer, html.Strict()))
+	require.Empty(t, html.Validate(tag.P(icon)))
+}
+
+func TestInvalidNumber(t *testing.T) {
+	require.Equal(t, "<circle/>", Circle(RFloat(math.NaN())).String())
+	err := Circle(RFloat(math.Inf(-1))).RenderTo(&bytes.Buffer{}, html.Strict())
+	require.EqualError(t, err, `html: strict: invalid number -Inf for attribute "r"`)
+}
+
+func TestValidateNesting(t *testing.T) {
+	require.Equal(t, []html.Violation{{
+		Path:    "body>svg>div",
+		Message: "<div> is an HTML element, so it is not allowed in <svg> unless it is inside of <foreignObject>, <desc>, or <title>",
+	}}, html.Validate(tag.Body(SVG(tag.Div()))))
+	require.Empty(t, html.Validate(tag.Body(SVG(ForeignObject(tag.P(html.InnerText("text")))))))
+}
+
+// TestCatalogMatchesSpec reads the package's source to find the constructors
+// and checks them against the SVG registry in pkg/spec.
+func TestCatalogMatchesSpec(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", nil, 0)
+	require.NoError(t, err)
+
+	elements := map[string]bool{}
+	attributes := map[string]bool{}
+	for _, file := range packages["svg"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			literal, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+			name, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			switch fun := call.Fun.(type) {
+			case *ast.SelectorExpr:
+				switch fun.Sel.Name {
+				case "NewForeignTag":
+					elements[name] = true
+				case "NewAttribute", "NewBoolAttribute":
+					attributes[name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	for _, element := range spec.SVGElements() {
+		require.True(t, elements[element.Name], "missing constructor for <%s>", element.Name)
+	}
+	require.Len(t, elements, len(spec.SVGElements()))
+	for _, attribute := range spec.SVGAttributes() {
+		require.True(t, attributes[attribute.Name], "missing constructor for %q", attribute.Name)
+	}
+	require.Len(t, attributes, len(spec.SVGAttributes()))
+}
@@ -0,0 +1,111 @@
+package tag
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/spec"
+	"github.com/stretchr/testify/require"
+)
+
+type specElement struct {
+	Name     string `json:"name"`
+	Func     string `json:"func"`
+	Void     bool   `json:"void"`
+	Obsolete bool   `json:"obsolete"`
+}
+
+// constructor is a function in the package that returns html.NewTag or
+// html.NewVoidTag.
+type constructor struct {
+	name       string
+	void       bool
+	deprecated bool
+}
+
+// parseConstructors finds the constructors by reading the package's source,
+// so the test checks the code that is checked in rather than the generator.
+func parseConstructors(t *testing.T) map[string]constructor {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	require.NoError(t, err)
+
+	constructors := map[string]constructor{}
+	for _, file := range packages["tag"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || len(fn.Body.List) != 1 {
+				continue
+			}
+			ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				continue
+			}
+			call, ok := ret.Results[0].(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (selector.Sel.Name != "NewTag" && selector.Sel.Name != "NewVoidTag") {
+				continue
+			}
+			literal := call.Args[0].(*ast.BasicLit)
+			name, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			constructors[fn.Name.Name] = constructor{
+				name:       name,
+				void:       selector.Sel.Name == "NewVoidTag",
+				deprecated: strings.Contains(fn.Doc.Text(), "\nDeprecated: "),
+			}
+		}
+	}
+	return constructors
+}
+
+func TestCatalogMatchesSpec(t *testing.T) {
+	data, err := os.ReadFile("../spec/elements.json")
+	require.NoError(t, err)
+	var elements []specElement
+	require.NoError(t, json.Unmarshal(data, &elements))
+
+	constructors := parseConstructors(t)
+	count := 0
+	for _, element := range elements {
+		if element.Func == "" {
+			continue
+		}
+		count++
+		c, ok := constructors[element.Func]
+		require.True(t, ok, "missing constructor %s for <%s>", element.Func, element.Name)
+		require.Equal(t, element.Name, c.name, element.Func)
+		require.Equal(t, element.Void, c.void, "void-ness of <%s>", element.Name)
+		require.Equal(t, element.Obsolete, c.deprecated, "deprecation of <%s>", element.Name)
+		require.Equal(t, spec.IsVoid(element.Name), c.void, "void-ness of <%s>", element.Name)
+	}
+	require.Len(t, constructors, count)
+}
+
+// TestVoidElements checks the catalog against the list of void elements in
+// the serialization algorithm of the WHATWG HTML standard.
+func TestVoidElements(t *testing.T) {
+	void := []string{
+		"area", "base", "basefont", "bgsound", "br", "col", "embed", "frame",
+		"hr", "img", "input", "keygen", "link", "meta", "param", "source",
+		"track", "wbr",
+	}
+	isVoid := map[string]bool{}
+	for _, name := range void {
+		isVoid[name] = true
+	}
+	for fn, c := range parseConstructors(t) {
+		require.Equal(t, isVoid[c.name], c.void, "%s constructs <%s>", fn, c.name)
+	}
+}
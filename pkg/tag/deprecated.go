@@ -0,0 +1,11 @@
+package tag
+
+import "github.com/jeffswenson/sanity/pkg/html"
+
+// WBr constructs an html.Node for the `<wbr>` tag.
+//
+// Deprecated: <wbr> is a void tag, so it has no children or closing tag. Use
+// Wbr instead.
+func WBr(children ...html.Node) html.Node {
+	return Wbr(children...)
+}
@@ -0,0 +1,6 @@
+// Package tag contains a constructor for every element in the HTML standard.
+//
+// The constructors are generated from pkg/spec/elements.json, a copy of the
+// element index in the WHATWG HTML standard. Constructors for obsolete
+// elements are marked as deprecated.
+package tag
@@ -1,3 +1,5 @@
+// Code generated by internal/specgen from pkg/spec/elements.json. DO NOT EDIT.
+
 package tag
 
 import "github.com/jeffswenson/sanity/pkg/html"
@@ -47,6 +49,8 @@ func Abbr(children ...html.Node) html.Node {
 // Administration">NASA</acronym>.<p>
 // <p>Please bring your <acronym title="Identification">ID</acronym> to the
 // meeting.<p>
+//
+// Deprecated: <acronym> is obsolete in the HTML standard. Use Abbr instead.
 func Acronym(children ...html.Node) html.Node {
 	return html.NewTag("acronym", children...)
 }
@@ -84,6 +88,9 @@ func Address(children ...html.Node) html.Node {
 // <applet code="MyApplet.class" width="300" height="200">
 // Your browser does not support Java applets.
 // </applet>
+//
+// Deprecated: <applet> is obsolete in the HTML standard. Use Embed or Object
+// instead.
 func Applet(children ...html.Node) html.Node {
 	return html.NewTag("applet", children...)
 }
@@ -153,6 +160,19 @@ func Audio(children ...html.Node) html.Node {
 	return html.NewTag("audio", children...)
 }
 
+// B constructs an html.Node for the `<b>` tag.
+//
+// The <b> tag draws attention to a span of text without giving it extra
+// importance, like the keywords in a document abstract, the product names in a
+// review, or the lede of an article. It is rendered in bold by default. Use
+// <strong> when the text is important and <em> when it is stressed.
+//
+// Example Usage:
+// <p>The <b>sanity</b> package renders HTML without templates.</p>
+func B(children ...html.Node) html.Node {
+	return html.NewTag("b", children...)
+}
+
 // BDI constructs an html.Node for the `<bdi>` tag.
 //
 // The <bdi> tag is used to isolate a section of text that has a different
@@ -198,6 +218,8 @@ func BDO(children ...html.Node) html.Node {
 // Example Usage:
 // <p>This is a <big>big</big> heading.</p>
 // <span>Click <big>here</big> to read more.</span>
+//
+// Deprecated: <big> is obsolete in the HTML standard. Use CSS instead.
 func Big(children ...html.Node) html.Node {
 	return html.NewTag("big", children...)
 }
@@ -325,6 +347,8 @@ func Caption(children ...html.Node) html.Node {
 // <h1>This heading is center-aligned</h1>
 // <p>This paragraph is also center-aligned</p>
 // </center>
+//
+// Deprecated: <center> is obsolete in the HTML standard. Use CSS instead.
 func Center(children ...html.Node) html.Node {
 	return html.NewTag("center", children...)
 }
@@ -535,6 +559,8 @@ func Dialog(children ...html.Node) html.Node {
 // <li>Folder 3</li>
 // </ul>
 // </dir>
+//
+// Deprecated: <dir> is obsolete in the HTML standard. Use UL instead.
 func Dir(children ...html.Node) html.Node {
 	return html.NewTag("dir", children...)
 }
@@ -680,6 +706,8 @@ func Figure(children ...html.Node) html.Node {
 // <p style="font-size: 20px;">This text has a font size of 20 pixels.</p>
 // <p style="color: red;">This text is displayed in red color.</p>
 // <p style="font-family: Arial;">This text is displayed in the Arial font.</p>
+//
+// Deprecated: <font> is obsolete in the HTML standard. Use CSS instead.
 func Font(children ...html.Node) html.Node {
 	return html.NewTag("font", children...)
 }
@@ -740,25 +768,6 @@ func Form(children ...html.Node) html.Node {
 	return html.NewTag("form", children...)
 }
 
-// Frame constructs an html.Node for the `<frame>` tag.
-//
-// The <frame> tag is used to define an individual frame within a frameset in an
-// HTML document. It is primarily used to create a layout with multiple sections
-// or windows, each displaying separate web pages or content. The <frame> tag
-// requires the `src` attribute to specify the URL of the content to be displayed
-// in that frame. The frameset element contains multiple <frame> tags to define
-// the layout and structure of the frames. Each frame can be resized, scrolled,
-// and targeted individually for specific actions.
-//
-// Example Usage:
-// <frameset rows="50%, 50%">
-// <frame src="top.html">
-// <frame src="bottom.html">
-// </frameset>
-func Frame(children ...html.Node) html.Node {
-	return html.NewTag("frame", children...)
-}
-
 // FrameSet constructs an html.Node for the `<frameset>` tag.
 //
 // The <frameset> tag is used to define a set of frames within an HTML
@@ -773,80 +782,13 @@ func Frame(children ...html.Node) html.Node {
 // <frame src="menu.html" />
 // <frame src="content.html" />
 // </frameset>
+//
+// Deprecated: <frameset> is obsolete in the HTML standard. Use IFrame and CSS
+// instead.
 func FrameSet(children ...html.Node) html.Node {
 	return html.NewTag("frameset", children...)
 }
 
-// Head constructs an html.Node for the `<head>` tag.
-//
-// The <head> tag is used to define the head section of an HTML document. It
-// contains metadata and other non-visible information about the document, such
-// as the document title, character encoding, and linked style sheets. This tag
-// does not display any visible content on a webpage, but it plays a crucial
-// role in providing instructions and information to web browsers and search
-// engines.
-//
-// Example Usage:
-// <!DOCTYPE html>
-// <html>
-// <head>
-// <title>My Webpage</title>
-// <meta charset="UTF-8">
-// <link rel="stylesheet" href="styles.css">
-// </head>
-// <body>
-// ...
-// </body>
-// </html>
-func Head(children ...html.Node) html.Node {
-	return html.NewTag("head", children...)
-}
-
-// Header constructs an html.Node for the `<header>` tag.
-//
-// The <header> tag is used to define the introductory or navigational section
-// of a document or section. It typically contains the logo, title, and
-// navigation elements of a webpage. The <header> tag helps structure the page
-// and improves accessibility for users. It is often placed at the top of the
-// document or within specific sections.
-//
-// Example Usage:
-// <header>
-// <h1>Welcome to Example Website</h1>
-// <nav>
-// <ul>
-// <li><a href="home.html">Home</a></li>
-// <li><a href="about.html">About</a></li>
-// <li><a href="products.html">Products</a></li>
-// <li><a href="contact.html">Contact</a></li>
-// </ul>
-// </nav>
-// </header>
-func Header(children ...html.Node) html.Node {
-	return html.NewTag("header", children...)
-}
-
-// HGroup constructs an html.Node for the `<hgroup>` tag.
-//
-// The <hgroup> tag is used to group heading elements together in an HTML
-// document. It is primarily used to create a hierarchical structure for
-// headings, allowing for better organization and readability. The <hgroup> tag
-// can be used to group multiple <h1> to <h6> tags within a section or article,
-// indicating that they are related and part of the same heading group.
-//
-// Example Usage:
-// <hgroup>
-// <h1>Main Heading</h1>
-// <h2>Subheading 1</h2>
-// <h3>Subheading 2</h3>
-// </hgroup>
-//
-// In this example, the <hgroup> tag is used to group the main heading and its
-// subheadings together, indicating their hierarchical relationship.
-func HGroup(children ...html.Node) html.Node {
-	return html.NewTag("hgroup", children...)
-}
-
 // H1 constructs an html.Node for the `<h1>` tag.
 //
 // The <h1> tag is used in HTML to define the highest level of headings on a
@@ -928,6 +870,88 @@ func H5(children ...html.Node) html.Node {
 	return html.NewTag("h5", children...)
 }
 
+// H6 constructs an html.Node for the `<h6>` tag.
+//
+// The <h6> tag is used to define the sixth level heading in an HTML
+// document. It is the lowest heading level and is typically used for the
+// least important headings on a page.
+//
+// Example Usage:
+// <h6>Footnotes</h6>
+func H6(children ...html.Node) html.Node {
+	return html.NewTag("h6", children...)
+}
+
+// Head constructs an html.Node for the `<head>` tag.
+//
+// The <head> tag is used to define the head section of an HTML document. It
+// contains metadata and other non-visible information about the document, such
+// as the document title, character encoding, and linked style sheets. This tag
+// does not display any visible content on a webpage, but it plays a crucial
+// role in providing instructions and information to web browsers and search
+// engines.
+//
+// Example Usage:
+// <!DOCTYPE html>
+// <html>
+// <head>
+// <title>My Webpage</title>
+// <meta charset="UTF-8">
+// <link rel="stylesheet" href="styles.css">
+// </head>
+// <body>
+// ...
+// </body>
+// </html>
+func Head(children ...html.Node) html.Node {
+	return html.NewTag("head", children...)
+}
+
+// Header constructs an html.Node for the `<header>` tag.
+//
+// The <header> tag is used to define the introductory or navigational section
+// of a document or section. It typically contains the logo, title, and
+// navigation elements of a webpage. The <header> tag helps structure the page
+// and improves accessibility for users. It is often placed at the top of the
+// document or within specific sections.
+//
+// Example Usage:
+// <header>
+// <h1>Welcome to Example Website</h1>
+// <nav>
+// <ul>
+// <li><a href="home.html">Home</a></li>
+// <li><a href="about.html">About</a></li>
+// <li><a href="products.html">Products</a></li>
+// <li><a href="contact.html">Contact</a></li>
+// </ul>
+// </nav>
+// </header>
+func Header(children ...html.Node) html.Node {
+	return html.NewTag("header", children...)
+}
+
+// HGroup constructs an html.Node for the `<hgroup>` tag.
+//
+// The <hgroup> tag is used to group heading elements together in an HTML
+// document. It is primarily used to create a hierarchical structure for
+// headings, allowing for better organization and readability. The <hgroup> tag
+// can be used to group multiple <h1> to <h6> tags within a section or article,
+// indicating that they are related and part of the same heading group.
+//
+// Example Usage:
+// <hgroup>
+// <h1>Main Heading</h1>
+// <h2>Subheading 1</h2>
+// <h3>Subheading 2</h3>
+// </hgroup>
+//
+// In this example, the <hgroup> tag is used to group the main heading and its
+// subheadings together, indicating their hierarchical relationship.
+func HGroup(children ...html.Node) html.Node {
+	return html.NewTag("hgroup", children...)
+}
+
 // HTML constructs an html.Node for the `<html>` tag.
 //
 // The <html> tag is the root element of an HTML document. It defines the
@@ -952,6 +976,18 @@ func HTML(children ...html.Node) html.Node {
 	return html.NewTag("html", children...)
 }
 
+// I constructs an html.Node for the `<i>` tag.
+//
+// The <i> tag marks text in an alternate voice or mood, like a technical
+// term, a phrase from another language, a thought, or a ship name. It is
+// rendered in italics by default. Use <em> for stress emphasis instead.
+//
+// Example Usage:
+// <p>The <i lang="fr">croissant</i> was still warm.</p>
+func I(children ...html.Node) html.Node {
+	return html.NewTag("i", children...)
+}
+
 // IFrame constructs an html.Node for the `<iframe>` tag.
 //
 // The <iframe> tag is used to embed another HTML document within the current
@@ -1124,6 +1160,23 @@ func Mark(children ...html.Node) html.Node {
 	return html.NewTag("mark", children...)
 }
 
+// Math constructs an html.Node for the `<math>` tag.
+//
+// The <math> tag is the root of a MathML formula embedded in an HTML
+// document. Its children are MathML elements, like <mi>, <mo>, and <mn>,
+// rather than HTML elements. The `display` attribute controls whether the
+// formula is rendered inline or as a block.
+//
+// Use pkg/mathml to build the children of <math>. mathml.Math constructs the
+// root as a foreign element, which is checked against the MathML registry in
+// strict mode.
+//
+// Example Usage:
+// <math display="block"><mi>x</mi><mo>=</mo><mn>2</mn></math>
+func Math(children ...html.Node) html.Node {
+	return html.NewTag("math", children...)
+}
+
 // Menu constructs an html.Node for the `<menu>` tag.
 //
 // The <menu> tag is used to define a list of commands or choices in an HTML
@@ -1157,6 +1210,9 @@ func Menu(children ...html.Node) html.Node {
 // <menuitem label="Copy" onclick="copy()">Copy</menuitem>
 // <menuitem label="Paste" onclick="paste()">Paste</menuitem>
 // </menu>
+//
+// Deprecated: <menuitem> is obsolete in the HTML standard. Use a script that
+// handles the contextmenu event instead.
 func MenuItem(children ...html.Node) html.Node {
 	return html.NewTag("menuitem", children...)
 }
@@ -1214,6 +1270,9 @@ func Nav(children ...html.Node) html.Node {
 // <noframes>
 // <p>Your browser does not support frames. Please upgrade to a modern browser to view this website.</p>
 // </noframes>
+//
+// Deprecated: <noframes> is obsolete in the HTML standard. Use IFrame and CSS
+// instead.
 func NoFrames(children ...html.Node) html.Node {
 	return html.NewTag("noframes", children...)
 }
@@ -1422,6 +1481,19 @@ func Progress(children ...html.Node) html.Node {
 	return html.NewTag("progress", children...)
 }
 
+// Q constructs an html.Node for the `<q>` tag.
+//
+// The <q> tag marks a short inline quotation from another source. Browsers
+// add the quotation marks, so they should not be included in the content. The
+// optional `cite` attribute is the URL of the source. Use <blockquote> for
+// longer quotations.
+//
+// Example Usage:
+// <p>The spec says <q cite="https://html.spec.whatwg.org/">the q element represents some phrasing content quoted from another source</q>.</p>
+func Q(children ...html.Node) html.Node {
+	return html.NewTag("q", children...)
+}
+
 // RP constructs an html.Node for the `<rp>` tag.
 //
 // The <rp> tag is used to provide fallback content for browsers that do not
@@ -1482,6 +1554,18 @@ func Ruby(children ...html.Node) html.Node {
 	return html.NewTag("ruby", children...)
 }
 
+// S constructs an html.Node for the `<s>` tag.
+//
+// The <s> tag marks content that is no longer accurate or relevant, like an
+// old price. It is rendered with a line through it by default. Use <del> when
+// the text marks an edit to the document.
+//
+// Example Usage:
+// <p><s>$40</s> $30</p>
+func S(children ...html.Node) html.Node {
+	return html.NewTag("s", children...)
+}
+
 // Samp constructs an html.Node for the `<samp>` tag.
 //
 // The `<samp>` tag is used to indicate sample output or example code in an
@@ -1524,6 +1608,24 @@ func Script(children ...html.Node) html.Node {
 	return html.NewTag("script", children...)
 }
 
+// Search constructs an html.Node for the `<search>` tag.
+//
+// The <search> tag groups the form controls and other content used to search
+// or filter the page or the site. It gives assistive technologies a search
+// landmark without needing role="search".
+//
+// Example Usage:
+// <search>
+// <form action="/search">
+// <label for="query">Find an article</label>
+// <input id="query" name="q" type="search">
+// <button>Go</button>
+// </form>
+// </search>
+func Search(children ...html.Node) html.Node {
+	return html.NewTag("search", children...)
+}
+
 // Section constructs an html.Node for the `<section>` tag.
 //
 // The <section> tag is used to define a section within an HTML document. It
@@ -1568,6 +1670,22 @@ func Select(children ...html.Node) html.Node {
 	return html.NewTag("select", children...)
 }
 
+// SelectedContent constructs an html.Node for the `<selectedcontent>` tag.
+//
+// The <selectedcontent> tag is placed in the <button> of a customizable
+// <select>. The browser replaces its children with a copy of the content of
+// the selected <option>, so the button always shows the current choice.
+//
+// Example Usage:
+// <select>
+// <button><selectedcontent></selectedcontent></button>
+// <option>Apple</option>
+// <option>Orange</option>
+// </select>
+func SelectedContent(children ...html.Node) html.Node {
+	return html.NewTag("selectedcontent", children...)
+}
+
 // Slot constructs an html.Node for the `<slot>` tag.
 //
 // The `<slot>` tag is used in the context of Web Components and the Shadow DOM
@@ -1631,6 +1749,9 @@ func Span(children ...html.Node) html.Node {
 // Example Usage:
 // <p>This is <strike>incorrect</strike> deprecated information.</p>
 // <span>This item is <strike>sold out</strike>.</span>
+//
+// Deprecated: <strike> is obsolete in the HTML standard. Use Del to mark an
+// edit, otherwise use S.
 func Strike(children ...html.Node) html.Node {
 	return html.NewTag("strike", children...)
 }
@@ -1746,6 +1867,9 @@ func Sup(children ...html.Node) html.Node {
 // graphic elements using XML-based syntax. It supports various attributes and
 // style properties to control the appearance and behavior of the graphics.
 //
+// Use pkg/svg to build the children of <svg>. svg.SVG constructs the root as
+// a foreign element, which is checked against the SVG registry in strict mode.
+//
 // Example usage:
 // <svg width="200" height="200">
 // <rect x="50" y="50" width="100" height="100" fill="red" />
@@ -2054,10 +2178,25 @@ func TR(children ...html.Node) html.Node {
 // Example Usage:
 // <tt>printf("Hello, World!");</tt>
 // <tt><input type="text" value="Username"></tt>
+//
+// Deprecated: <tt> is obsolete in the HTML standard. Use Kbd, Var, Code, or
+// Samp instead.
 func TT(children ...html.Node) html.Node {
 	return html.NewTag("tt", children...)
 }
 
+// U constructs an html.Node for the `<u>` tag.
+//
+// The <u> tag marks text with an unarticulated, non-textual annotation, like
+// a misspelled word or a proper name in Chinese text. It is underlined by
+// default, so avoid it where the text could be mistaken for a hyperlink.
+//
+// Example Usage:
+// <p>Check the spelling of <u class="spelling">recieve</u>.</p>
+func U(children ...html.Node) html.Node {
+	return html.NewTag("u", children...)
+}
+
 // UL constructs an html.Node for the `<ul>` tag.
 //
 // The <ul> tag is used to create an unordered list in an HTML document.
@@ -2110,18 +2249,3 @@ func Var(children ...html.Node) html.Node {
 func Video(children ...html.Node) html.Node {
 	return html.NewTag("video", children...)
 }
-
-// WBr constructs an html.Node for the `<wbr>` tag.
-//
-// The <wbr> tag in HTML is used to specify a possible line-break. It primarily
-// allows the browser to break a line at the specified point if the line is too
-// long for its container. The <wbr> tag does not have any visual
-// representation unless it enforces a line break. It is mainly useful when
-// dealing with long words or strings that could potentially break the layout
-// if not broken into multiple lines.
-//
-// Example Usage:
-// <p>This is a verylongwordthatmightneeda<wbr>break.</p>
-func WBr(children ...html.Node) html.Node {
-	return html.NewTag("wbr", children...)
-}
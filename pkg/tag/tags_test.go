@@ -3,6 +3,7 @@ package tag
 import (
 	"testing"
 
+	"github.com/jeffswenson/sanity/pkg/html"
 	"github.com/stretchr/testify/require"
 )
 
@@ -150,10 +151,6 @@ func TestForm(t *testing.T) {
 	require.Equal(t, "<form></form>", Form().String())
 }
 
-func TestFrame(t *testing.T) {
-	require.Equal(t, "<frame></frame>", Frame().String())
-}
-
 func TestFrameSet(t *testing.T) {
 	require.Equal(t, "<frameset></frameset>", FrameSet().String())
 }
@@ -415,5 +412,41 @@ func TestVideo(t *testing.T) {
 }
 
 func TestWBr(t *testing.T) {
-	require.Equal(t, "<wbr></wbr>", WBr().String())
+	require.Equal(t, "<wbr>", WBr(html.InnerText("ignored")).String())
+}
+
+func TestB(t *testing.T) {
+	require.Equal(t, "<b></b>", B().String())
+}
+
+func TestI(t *testing.T) {
+	require.Equal(t, "<i></i>", I().String())
+}
+
+func TestU(t *testing.T) {
+	require.Equal(t, "<u></u>", U().String())
+}
+
+func TestS(t *testing.T) {
+	require.Equal(t, "<s></s>", S().String())
+}
+
+func TestQ(t *testing.T) {
+	require.Equal(t, "<q></q>", Q().String())
+}
+
+func TestH6(t *testing.T) {
+	require.Equal(t, "<h6></h6>", H6().String())
+}
+
+func TestSearch(t *testing.T) {
+	require.Equal(t, "<search></search>", Search().String())
+}
+
+func TestMath(t *testing.T) {
+	require.Equal(t, "<math></math>", Math().String())
+}
+
+func TestSelectedContent(t *testing.T) {
+	require.Equal(t, "<selectedcontent></selectedcontent>", SelectedContent().String())
 }
@@ -1,3 +1,5 @@
+// Code generated by internal/specgen from pkg/spec/elements.json. DO NOT EDIT.
+
 package tag
 
 import "github.com/jeffswenson/sanity/pkg/html"
@@ -57,6 +59,8 @@ func Base(children ...html.Node) html.Node {
 // <h1>This heading has the same font style as the paragraph above.</h1>
 // </body>
 // </html>
+//
+// Deprecated: <basefont> is obsolete in the HTML standard. Use CSS instead.
 func BaseFont(children ...html.Node) html.Node {
 	return html.NewVoidTag("basefont", children...)
 }
@@ -117,6 +121,28 @@ func Embed(children ...html.Node) html.Node {
 	return html.NewVoidTag("embed", children...)
 }
 
+// Frame constructs an html.Node for the `<frame>` tag.
+//
+// The <frame> tag is used to define an individual frame within a frameset in an
+// HTML document. It is primarily used to create a layout with multiple sections
+// or windows, each displaying separate web pages or content. The <frame> tag
+// requires the `src` attribute to specify the URL of the content to be displayed
+// in that frame. The frameset element contains multiple <frame> tags to define
+// the layout and structure of the frames. Each frame can be resized, scrolled,
+// and targeted individually for specific actions.
+//
+// Example Usage:
+// <frameset rows="50%, 50%">
+// <frame src="top.html">
+// <frame src="bottom.html">
+// </frameset>
+//
+// Deprecated: <frame> is obsolete in the HTML standard. Use IFrame and CSS
+// instead.
+func Frame(children ...html.Node) html.Node {
+	return html.NewVoidTag("frame", children...)
+}
+
 // Hr constructs an html.Node for the `<hr>` tag.
 //
 // The <hr> tag is used to create a horizontal rule or a line in an HTML
@@ -218,6 +244,9 @@ func Meta(children ...html.Node) html.Node {
 // <param name="loop" value="false">
 // <param name="src" value="video.mp4">
 // </object>
+//
+// Deprecated: <param> is obsolete in the HTML standard. Use the data attribute
+// of Object instead.
 func Param(children ...html.Node) html.Node {
 	return html.NewVoidTag("param", children...)
 }
@@ -30,6 +30,10 @@ func TestEmbed(t *testing.T) {
 	require.Equal(t, "<embed>", Embed().String())
 }
 
+func TestFrame(t *testing.T) {
+	require.Equal(t, "<frame>", Frame().String())
+}
+
 func TestHr(t *testing.T) {
 	require.Equal(t, "<hr>", Hr().String())
 }
@@ -61,3 +65,7 @@ func TestSource(t *testing.T) {
 func TestTrack(t *testing.T) {
 	require.Equal(t, "<track>", Track().String())
 }
+
+func TestWbr(t *testing.T) {
+	require.Equal(t, "<wbr>", Wbr().String())
+}
@@ -0,0 +1,1799 @@
+// Code generated by internal/specgen from pkg/spec/attributes.json. DO NOT EDIT.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/jeffswenson/sanity/pkg/attr"
+)
+
+// AbbrAttribute is the `abbr` attribute, which applies to <th>.
+type AbbrAttribute struct{ option }
+
+func (AbbrAttribute) thOption() {}
+
+// AbbrAttr constructs the `abbr` attribute. See attr.Abbr.
+func AbbrAttr(value string) AbbrAttribute {
+	return AbbrAttribute{option{attr.Abbr(value)}}
+}
+
+// AcceptAttribute is the `accept` attribute, which applies to <input>.
+type AcceptAttribute struct{ option }
+
+func (AcceptAttribute) inputOption() {}
+
+// Accept constructs the `accept` attribute. See attr.Accept.
+func Accept(value string) AcceptAttribute {
+	return AcceptAttribute{option{attr.Accept(value)}}
+}
+
+// AcceptCharSetAttribute is the `accept-charset` attribute, which applies to
+// <form>.
+type AcceptCharSetAttribute struct{ option }
+
+func (AcceptCharSetAttribute) formOption() {}
+
+// AcceptCharSet constructs the `accept-charset` attribute. See attr.AcceptCharSet.
+func AcceptCharSet(value string) AcceptCharSetAttribute {
+	return AcceptCharSetAttribute{option{attr.AcceptCharSet(value)}}
+}
+
+// AccessKey constructs the `accesskey` attribute. See attr.AccessKey.
+func AccessKey(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.AccessKey(value)}}
+}
+
+// ActionAttribute is the `action` attribute, which applies to <form>.
+type ActionAttribute struct{ option }
+
+func (ActionAttribute) formOption() {}
+
+// Action constructs the `action` attribute. See attr.Action.
+func Action(value string) ActionAttribute {
+	return ActionAttribute{option{attr.Action(value)}}
+}
+
+// AllowAttribute is the `allow` attribute, which applies to <iframe>.
+type AllowAttribute struct{ option }
+
+func (AllowAttribute) iframeOption() {}
+
+// Allow constructs the `allow` attribute. See attr.Allow.
+func Allow(value string) AllowAttribute {
+	return AllowAttribute{option{attr.Allow(value)}}
+}
+
+// AllowFullScreenAttribute is the `allowfullscreen` attribute, which applies to
+// <iframe>.
+type AllowFullScreenAttribute struct{ option }
+
+func (AllowFullScreenAttribute) iframeOption() {}
+
+// AllowFullScreen constructs the `allowfullscreen` attribute. See attr.AllowFullScreen.
+func AllowFullScreen() AllowFullScreenAttribute {
+	return AllowFullScreenAttribute{option{attr.AllowFullScreen()}}
+}
+
+// AllowFullScreenIf constructs the `allowfullscreen` attribute if condition is true. See attr.AllowFullScreenIf.
+func AllowFullScreenIf(condition bool) AllowFullScreenAttribute {
+	return AllowFullScreenAttribute{option{attr.AllowFullScreenIf(condition)}}
+}
+
+// AltAttribute is the `alt` attribute, which applies to <area>, <img>, and
+// <input>.
+type AltAttribute struct{ option }
+
+func (AltAttribute) areaOption()  {}
+func (AltAttribute) imgOption()   {}
+func (AltAttribute) inputOption() {}
+
+// Alt constructs the `alt` attribute. See attr.Alt.
+func Alt(value string) AltAttribute {
+	return AltAttribute{option{attr.Alt(value)}}
+}
+
+// AsAttribute is the `as` attribute, which applies to <link>.
+type AsAttribute struct{ option }
+
+func (AsAttribute) linkOption() {}
+
+// As constructs the `as` attribute. See attr.As.
+func As(value string) AsAttribute {
+	return AsAttribute{option{attr.As(value)}}
+}
+
+// AsKeyword constructs the `as` attribute from one of the attr.AsValue
+// constants. See attr.AsKeyword.
+func AsKeyword(value attr.AsValue) AsAttribute {
+	return AsAttribute{option{attr.AsKeyword(value)}}
+}
+
+// AsyncAttribute is the `async` attribute, which applies to <script>.
+type AsyncAttribute struct{ option }
+
+func (AsyncAttribute) scriptOption() {}
+
+// Async constructs the `async` attribute. See attr.Async.
+func Async() AsyncAttribute {
+	return AsyncAttribute{option{attr.Async()}}
+}
+
+// AsyncIf constructs the `async` attribute if condition is true. See attr.AsyncIf.
+func AsyncIf(condition bool) AsyncAttribute {
+	return AsyncAttribute{option{attr.AsyncIf(condition)}}
+}
+
+// AutoCapitalize constructs the `autocapitalize` attribute. See attr.AutoCapitalize.
+func AutoCapitalize(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.AutoCapitalize(value)}}
+}
+
+// AutoCapitalizeKeyword constructs the `autocapitalize` attribute from one of
+// the attr.AutoCapitalizeValue constants. See attr.AutoCapitalizeKeyword.
+func AutoCapitalizeKeyword(value attr.AutoCapitalizeValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.AutoCapitalizeKeyword(value)}}
+}
+
+// AutoCompleteAttribute is the `autocomplete` attribute, which applies to
+// <form>, <input>, <select>, and <textarea>.
+type AutoCompleteAttribute struct{ option }
+
+func (AutoCompleteAttribute) formOption()     {}
+func (AutoCompleteAttribute) inputOption()    {}
+func (AutoCompleteAttribute) selectOption()   {}
+func (AutoCompleteAttribute) textareaOption() {}
+
+// AutoComplete constructs the `autocomplete` attribute. See attr.AutoComplete.
+func AutoComplete(value string) AutoCompleteAttribute {
+	return AutoCompleteAttribute{option{attr.AutoComplete(value)}}
+}
+
+// AutoCompleteKeywords constructs the `autocomplete` attribute from
+// attr.AutoCompleteValue constants, which are joined by spaces. See
+// attr.AutoCompleteKeywords.
+func AutoCompleteKeywords(values ...attr.AutoCompleteValue) AutoCompleteAttribute {
+	return AutoCompleteAttribute{option{attr.AutoCompleteKeywords(values...)}}
+}
+
+// AutoFocus constructs the `autofocus` attribute. See attr.AutoFocus.
+func AutoFocus() GlobalAttribute {
+	return GlobalAttribute{option{attr.AutoFocus()}}
+}
+
+// AutoFocusIf constructs the `autofocus` attribute if condition is true. See attr.AutoFocusIf.
+func AutoFocusIf(condition bool) GlobalAttribute {
+	return GlobalAttribute{option{attr.AutoFocusIf(condition)}}
+}
+
+// AutoPlayAttribute is the `autoplay` attribute, which applies to <audio> and
+// <video>.
+type AutoPlayAttribute struct{ option }
+
+func (AutoPlayAttribute) audioOption() {}
+func (AutoPlayAttribute) videoOption() {}
+
+// AutoPlay constructs the `autoplay` attribute. See attr.AutoPlay.
+func AutoPlay() AutoPlayAttribute {
+	return AutoPlayAttribute{option{attr.AutoPlay()}}
+}
+
+// AutoPlayIf constructs the `autoplay` attribute if condition is true. See attr.AutoPlayIf.
+func AutoPlayIf(condition bool) AutoPlayAttribute {
+	return AutoPlayAttribute{option{attr.AutoPlayIf(condition)}}
+}
+
+// BlockingAttribute is the `blocking` attribute, which applies to <link>,
+// <script>, and <style>.
+type BlockingAttribute struct{ option }
+
+func (BlockingAttribute) linkOption()   {}
+func (BlockingAttribute) scriptOption() {}
+func (BlockingAttribute) styleOption()  {}
+
+// Blocking constructs the `blocking` attribute. See attr.Blocking.
+func Blocking(value string) BlockingAttribute {
+	return BlockingAttribute{option{attr.Blocking(value)}}
+}
+
+// CharSetAttribute is the `charset` attribute, which applies to <meta>.
+type CharSetAttribute struct{ option }
+
+func (CharSetAttribute) metaOption() {}
+
+// CharSet constructs the `charset` attribute. See attr.CharSet.
+func CharSet(value string) CharSetAttribute {
+	return CharSetAttribute{option{attr.CharSet(value)}}
+}
+
+// CheckedAttribute is the `checked` attribute, which applies to <input>.
+type CheckedAttribute struct{ option }
+
+func (CheckedAttribute) inputOption() {}
+
+// Checked constructs the `checked` attribute. See attr.Checked.
+func Checked() CheckedAttribute {
+	return CheckedAttribute{option{attr.Checked()}}
+}
+
+// CheckedIf constructs the `checked` attribute if condition is true. See attr.CheckedIf.
+func CheckedIf(condition bool) CheckedAttribute {
+	return CheckedAttribute{option{attr.CheckedIf(condition)}}
+}
+
+// CiteAttribute is the `cite` attribute, which applies to <blockquote>, <del>,
+// <ins>, and <q>.
+type CiteAttribute struct{ option }
+
+func (CiteAttribute) blockquoteOption() {}
+func (CiteAttribute) delOption()        {}
+func (CiteAttribute) insOption()        {}
+func (CiteAttribute) qOption()          {}
+
+// CiteAttr constructs the `cite` attribute. See attr.Cite.
+func CiteAttr(value string) CiteAttribute {
+	return CiteAttribute{option{attr.Cite(value)}}
+}
+
+// Class constructs the `class` attribute. See attr.Class.
+func Class(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Class(value)}}
+}
+
+// ColorAttribute is the `color` attribute, which applies to <link>.
+type ColorAttribute struct{ option }
+
+func (ColorAttribute) linkOption() {}
+
+// Color constructs the `color` attribute. See attr.Color.
+func Color(value string) ColorAttribute {
+	return ColorAttribute{option{attr.Color(value)}}
+}
+
+// ColsAttribute is the `cols` attribute, which applies to <textarea>.
+type ColsAttribute struct{ option }
+
+func (ColsAttribute) textareaOption() {}
+
+// Cols constructs the `cols` attribute. See attr.Cols.
+func Cols(value string) ColsAttribute {
+	return ColsAttribute{option{attr.Cols(value)}}
+}
+
+// ColsInt constructs the `cols` attribute from an int. See attr.ColsInt.
+func ColsInt(value int) ColsAttribute {
+	return ColsAttribute{option{attr.ColsInt(value)}}
+}
+
+// ColSpanAttribute is the `colspan` attribute, which applies to <td> and <th>.
+type ColSpanAttribute struct{ option }
+
+func (ColSpanAttribute) tdOption() {}
+func (ColSpanAttribute) thOption() {}
+
+// ColSpan constructs the `colspan` attribute. See attr.ColSpan.
+func ColSpan(value string) ColSpanAttribute {
+	return ColSpanAttribute{option{attr.ColSpan(value)}}
+}
+
+// ColSpanInt constructs the `colspan` attribute from an int. See
+// attr.ColSpanInt.
+func ColSpanInt(value int) ColSpanAttribute {
+	return ColSpanAttribute{option{attr.ColSpanInt(value)}}
+}
+
+// ContentAttribute is the `content` attribute, which applies to <meta>.
+type ContentAttribute struct{ option }
+
+func (ContentAttribute) metaOption() {}
+
+// Content constructs the `content` attribute. See attr.Content.
+func Content(value string) ContentAttribute {
+	return ContentAttribute{option{attr.Content(value)}}
+}
+
+// ContentEditable constructs the `contenteditable` attribute. See attr.ContentEditable.
+func ContentEditable(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.ContentEditable(value)}}
+}
+
+// ContentEditableKeyword constructs the `contenteditable` attribute from one of
+// the attr.ContentEditableValue constants. See attr.ContentEditableKeyword.
+func ContentEditableKeyword(value attr.ContentEditableValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.ContentEditableKeyword(value)}}
+}
+
+// ControlsAttribute is the `controls` attribute, which applies to <audio> and
+// <video>.
+type ControlsAttribute struct{ option }
+
+func (ControlsAttribute) audioOption() {}
+func (ControlsAttribute) videoOption() {}
+
+// Controls constructs the `controls` attribute. See attr.Controls.
+func Controls() ControlsAttribute {
+	return ControlsAttribute{option{attr.Controls()}}
+}
+
+// ControlsIf constructs the `controls` attribute if condition is true. See attr.ControlsIf.
+func ControlsIf(condition bool) ControlsAttribute {
+	return ControlsAttribute{option{attr.ControlsIf(condition)}}
+}
+
+// CoordsAttribute is the `coords` attribute, which applies to <area>.
+type CoordsAttribute struct{ option }
+
+func (CoordsAttribute) areaOption() {}
+
+// Coords constructs the `coords` attribute. See attr.Coords.
+func Coords(value string) CoordsAttribute {
+	return CoordsAttribute{option{attr.Coords(value)}}
+}
+
+// CrossOriginAttribute is the `crossorigin` attribute, which applies to
+// <audio>, <img>, <link>, <script>, and <video>.
+type CrossOriginAttribute struct{ option }
+
+func (CrossOriginAttribute) audioOption()  {}
+func (CrossOriginAttribute) imgOption()    {}
+func (CrossOriginAttribute) linkOption()   {}
+func (CrossOriginAttribute) scriptOption() {}
+func (CrossOriginAttribute) videoOption()  {}
+
+// CrossOrigin constructs the `crossorigin` attribute. See attr.CrossOrigin.
+func CrossOrigin(value string) CrossOriginAttribute {
+	return CrossOriginAttribute{option{attr.CrossOrigin(value)}}
+}
+
+// CrossOriginKeyword constructs the `crossorigin` attribute from one of the
+// attr.CrossOriginValue constants. See attr.CrossOriginKeyword.
+func CrossOriginKeyword(value attr.CrossOriginValue) CrossOriginAttribute {
+	return CrossOriginAttribute{option{attr.CrossOriginKeyword(value)}}
+}
+
+// DataAttribute is the `data` attribute, which applies to <object>.
+type DataAttribute struct{ option }
+
+func (DataAttribute) objectOption() {}
+
+// DataAttr constructs the `data` attribute. See attr.Data.
+func DataAttr(value string) DataAttribute {
+	return DataAttribute{option{attr.Data(value)}}
+}
+
+// DateTimeAttribute is the `datetime` attribute, which applies to <del>, <ins>,
+// and <time>.
+type DateTimeAttribute struct{ option }
+
+func (DateTimeAttribute) delOption()  {}
+func (DateTimeAttribute) insOption()  {}
+func (DateTimeAttribute) timeOption() {}
+
+// DateTime constructs the `datetime` attribute. See attr.DateTime.
+func DateTime(value string) DateTimeAttribute {
+	return DateTimeAttribute{option{attr.DateTime(value)}}
+}
+
+// DateTimeAt constructs the `datetime` attribute from a time.Time. See
+// attr.DateTimeAt.
+func DateTimeAt(value time.Time) DateTimeAttribute {
+	return DateTimeAttribute{option{attr.DateTimeAt(value)}}
+}
+
+// DateTimeDuration constructs the `datetime` attribute from a time.Duration.
+// See attr.DateTimeDuration.
+func DateTimeDuration(value time.Duration) DateTimeAttribute {
+	return DateTimeAttribute{option{attr.DateTimeDuration(value)}}
+}
+
+// DecodingAttribute is the `decoding` attribute, which applies to <img>.
+type DecodingAttribute struct{ option }
+
+func (DecodingAttribute) imgOption() {}
+
+// Decoding constructs the `decoding` attribute. See attr.Decoding.
+func Decoding(value string) DecodingAttribute {
+	return DecodingAttribute{option{attr.Decoding(value)}}
+}
+
+// DecodingKeyword constructs the `decoding` attribute from one of the
+// attr.DecodingValue constants. See attr.DecodingKeyword.
+func DecodingKeyword(value attr.DecodingValue) DecodingAttribute {
+	return DecodingAttribute{option{attr.DecodingKeyword(value)}}
+}
+
+// DefaultAttribute is the `default` attribute, which applies to <track>.
+type DefaultAttribute struct{ option }
+
+func (DefaultAttribute) trackOption() {}
+
+// Default constructs the `default` attribute. See attr.Default.
+func Default() DefaultAttribute {
+	return DefaultAttribute{option{attr.Default()}}
+}
+
+// DefaultIf constructs the `default` attribute if condition is true. See attr.DefaultIf.
+func DefaultIf(condition bool) DefaultAttribute {
+	return DefaultAttribute{option{attr.DefaultIf(condition)}}
+}
+
+// DeferAttribute is the `defer` attribute, which applies to <script>.
+type DeferAttribute struct{ option }
+
+func (DeferAttribute) scriptOption() {}
+
+// Defer constructs the `defer` attribute. See attr.Defer.
+func Defer() DeferAttribute {
+	return DeferAttribute{option{attr.Defer()}}
+}
+
+// DeferIf constructs the `defer` attribute if condition is true. See attr.DeferIf.
+func DeferIf(condition bool) DeferAttribute {
+	return DeferAttribute{option{attr.DeferIf(condition)}}
+}
+
+// Dir constructs the `dir` attribute. See attr.Dir.
+func Dir(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Dir(value)}}
+}
+
+// DirKeyword constructs the `dir` attribute from one of the attr.DirValue
+// constants. See attr.DirKeyword.
+func DirKeyword(value attr.DirValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.DirKeyword(value)}}
+}
+
+// DirNameAttribute is the `dirname` attribute, which applies to <input> and
+// <textarea>.
+type DirNameAttribute struct{ option }
+
+func (DirNameAttribute) inputOption()    {}
+func (DirNameAttribute) textareaOption() {}
+
+// DirName constructs the `dirname` attribute. See attr.DirName.
+func DirName(value string) DirNameAttribute {
+	return DirNameAttribute{option{attr.DirName(value)}}
+}
+
+// DisabledAttribute is the `disabled` attribute, which applies to <button>,
+// <fieldset>, <input>, <link>, <optgroup>, <option>, <select>, and <textarea>.
+type DisabledAttribute struct{ option }
+
+func (DisabledAttribute) buttonOption()   {}
+func (DisabledAttribute) fieldsetOption() {}
+func (DisabledAttribute) inputOption()    {}
+func (DisabledAttribute) linkOption()     {}
+func (DisabledAttribute) optgroupOption() {}
+func (DisabledAttribute) optionOption()   {}
+func (DisabledAttribute) selectOption()   {}
+func (DisabledAttribute) textareaOption() {}
+
+// Disabled constructs the `disabled` attribute. See attr.Disabled.
+func Disabled() DisabledAttribute {
+	return DisabledAttribute{option{attr.Disabled()}}
+}
+
+// DisabledIf constructs the `disabled` attribute if condition is true. See attr.DisabledIf.
+func DisabledIf(condition bool) DisabledAttribute {
+	return DisabledAttribute{option{attr.DisabledIf(condition)}}
+}
+
+// Draggable constructs the `draggable` attribute. See attr.Draggable.
+func Draggable(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Draggable(value)}}
+}
+
+// DraggableKeyword constructs the `draggable` attribute from one of the
+// attr.DraggableValue constants. See attr.DraggableKeyword.
+func DraggableKeyword(value attr.DraggableValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.DraggableKeyword(value)}}
+}
+
+// EnctypeAttribute is the `enctype` attribute, which applies to <form>.
+type EnctypeAttribute struct{ option }
+
+func (EnctypeAttribute) formOption() {}
+
+// Enctype constructs the `enctype` attribute. See attr.Enctype.
+func Enctype(value string) EnctypeAttribute {
+	return EnctypeAttribute{option{attr.Enctype(value)}}
+}
+
+// EnctypeKeyword constructs the `enctype` attribute from one of the
+// attr.EncTypeValue constants. See attr.EnctypeKeyword.
+func EnctypeKeyword(value attr.EncTypeValue) EnctypeAttribute {
+	return EnctypeAttribute{option{attr.EnctypeKeyword(value)}}
+}
+
+// EnterKeyHint constructs the `enterkeyhint` attribute. See attr.EnterKeyHint.
+func EnterKeyHint(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.EnterKeyHint(value)}}
+}
+
+// EnterKeyHintKeyword constructs the `enterkeyhint` attribute from one of the
+// attr.EnterKeyHintValue constants. See attr.EnterKeyHintKeyword.
+func EnterKeyHintKeyword(value attr.EnterKeyHintValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.EnterKeyHintKeyword(value)}}
+}
+
+// FetchPriorityAttribute is the `fetchpriority` attribute, which applies to
+// <img>, <link>, and <script>.
+type FetchPriorityAttribute struct{ option }
+
+func (FetchPriorityAttribute) imgOption()    {}
+func (FetchPriorityAttribute) linkOption()   {}
+func (FetchPriorityAttribute) scriptOption() {}
+
+// FetchPriority constructs the `fetchpriority` attribute. See attr.FetchPriority.
+func FetchPriority(value string) FetchPriorityAttribute {
+	return FetchPriorityAttribute{option{attr.FetchPriority(value)}}
+}
+
+// FetchPriorityKeyword constructs the `fetchpriority` attribute from one of the
+// attr.FetchPriorityValue constants. See attr.FetchPriorityKeyword.
+func FetchPriorityKeyword(value attr.FetchPriorityValue) FetchPriorityAttribute {
+	return FetchPriorityAttribute{option{attr.FetchPriorityKeyword(value)}}
+}
+
+// ForAttribute is the `for` attribute, which applies to <label> and <output>.
+type ForAttribute struct{ option }
+
+func (ForAttribute) labelOption()  {}
+func (ForAttribute) outputOption() {}
+
+// For constructs the `for` attribute. See attr.For.
+func For(value string) ForAttribute {
+	return ForAttribute{option{attr.For(value)}}
+}
+
+// FormAttribute is the `form` attribute, which applies to <button>, <fieldset>,
+// <input>, <object>, <output>, <select>, and <textarea>.
+type FormAttribute struct{ option }
+
+func (FormAttribute) buttonOption()   {}
+func (FormAttribute) fieldsetOption() {}
+func (FormAttribute) inputOption()    {}
+func (FormAttribute) objectOption()   {}
+func (FormAttribute) outputOption()   {}
+func (FormAttribute) selectOption()   {}
+func (FormAttribute) textareaOption() {}
+
+// FormAttr constructs the `form` attribute. See attr.Form.
+func FormAttr(value string) FormAttribute {
+	return FormAttribute{option{attr.Form(value)}}
+}
+
+// FormActionAttribute is the `formaction` attribute, which applies to <button>
+// and <input>.
+type FormActionAttribute struct{ option }
+
+func (FormActionAttribute) buttonOption() {}
+func (FormActionAttribute) inputOption()  {}
+
+// FormAction constructs the `formaction` attribute. See attr.FormAction.
+func FormAction(value string) FormActionAttribute {
+	return FormActionAttribute{option{attr.FormAction(value)}}
+}
+
+// FormEncTypeAttribute is the `formenctype` attribute, which applies to
+// <button> and <input>.
+type FormEncTypeAttribute struct{ option }
+
+func (FormEncTypeAttribute) buttonOption() {}
+func (FormEncTypeAttribute) inputOption()  {}
+
+// FormEncType constructs the `formenctype` attribute. See attr.FormEncType.
+func FormEncType(value string) FormEncTypeAttribute {
+	return FormEncTypeAttribute{option{attr.FormEncType(value)}}
+}
+
+// FormEncTypeKeyword constructs the `formenctype` attribute from one of the
+// attr.EncTypeValue constants. See attr.FormEncTypeKeyword.
+func FormEncTypeKeyword(value attr.EncTypeValue) FormEncTypeAttribute {
+	return FormEncTypeAttribute{option{attr.FormEncTypeKeyword(value)}}
+}
+
+// FormMethodAttribute is the `formmethod` attribute, which applies to <button>
+// and <input>.
+type FormMethodAttribute struct{ option }
+
+func (FormMethodAttribute) buttonOption() {}
+func (FormMethodAttribute) inputOption()  {}
+
+// FormMethod constructs the `formmethod` attribute. See attr.FormMethod.
+func FormMethod(value string) FormMethodAttribute {
+	return FormMethodAttribute{option{attr.FormMethod(value)}}
+}
+
+// FormMethodKeyword constructs the `formmethod` attribute from one of the
+// attr.MethodValue constants. See attr.FormMethodKeyword.
+func FormMethodKeyword(value attr.MethodValue) FormMethodAttribute {
+	return FormMethodAttribute{option{attr.FormMethodKeyword(value)}}
+}
+
+// FormNoValidateAttribute is the `formnovalidate` attribute, which applies to
+// <button> and <input>.
+type FormNoValidateAttribute struct{ option }
+
+func (FormNoValidateAttribute) buttonOption() {}
+func (FormNoValidateAttribute) inputOption()  {}
+
+// FormNoValidate constructs the `formnovalidate` attribute. See attr.FormNoValidate.
+func FormNoValidate() FormNoValidateAttribute {
+	return FormNoValidateAttribute{option{attr.FormNoValidate()}}
+}
+
+// FormNoValidateIf constructs the `formnovalidate` attribute if condition is true. See attr.FormNoValidateIf.
+func FormNoValidateIf(condition bool) FormNoValidateAttribute {
+	return FormNoValidateAttribute{option{attr.FormNoValidateIf(condition)}}
+}
+
+// FormTargetAttribute is the `formtarget` attribute, which applies to <button>
+// and <input>.
+type FormTargetAttribute struct{ option }
+
+func (FormTargetAttribute) buttonOption() {}
+func (FormTargetAttribute) inputOption()  {}
+
+// FormTarget constructs the `formtarget` attribute. See attr.FormTarget.
+func FormTarget(value string) FormTargetAttribute {
+	return FormTargetAttribute{option{attr.FormTarget(value)}}
+}
+
+// FormTargetKeyword constructs the `formtarget` attribute from one of the
+// attr.TargetValue constants. See attr.FormTargetKeyword.
+func FormTargetKeyword(value attr.TargetValue) FormTargetAttribute {
+	return FormTargetAttribute{option{attr.FormTargetKeyword(value)}}
+}
+
+// HeadersAttribute is the `headers` attribute, which applies to <td> and <th>.
+type HeadersAttribute struct{ option }
+
+func (HeadersAttribute) tdOption() {}
+func (HeadersAttribute) thOption() {}
+
+// Headers constructs the `headers` attribute. See attr.Headers.
+func Headers(value string) HeadersAttribute {
+	return HeadersAttribute{option{attr.Headers(value)}}
+}
+
+// HeightAttribute is the `height` attribute, which applies to <canvas>,
+// <embed>, <iframe>, <img>, <input>, <object>, <source>, and <video>.
+type HeightAttribute struct{ option }
+
+func (HeightAttribute) canvasOption() {}
+func (HeightAttribute) embedOption()  {}
+func (HeightAttribute) iframeOption() {}
+func (HeightAttribute) imgOption()    {}
+func (HeightAttribute) inputOption()  {}
+func (HeightAttribute) objectOption() {}
+func (HeightAttribute) sourceOption() {}
+func (HeightAttribute) videoOption()  {}
+
+// Height constructs the `height` attribute. See attr.Height.
+func Height(value string) HeightAttribute {
+	return HeightAttribute{option{attr.Height(value)}}
+}
+
+// HeightInt constructs the `height` attribute from an int. See attr.HeightInt.
+func HeightInt(value int) HeightAttribute {
+	return HeightAttribute{option{attr.HeightInt(value)}}
+}
+
+// Hidden constructs the `hidden` attribute. See attr.Hidden.
+func Hidden() GlobalAttribute {
+	return GlobalAttribute{option{attr.Hidden()}}
+}
+
+// HiddenIf constructs the `hidden` attribute if condition is true. See attr.HiddenIf.
+func HiddenIf(condition bool) GlobalAttribute {
+	return GlobalAttribute{option{attr.HiddenIf(condition)}}
+}
+
+// HighAttribute is the `high` attribute, which applies to <meter>.
+type HighAttribute struct{ option }
+
+func (HighAttribute) meterOption() {}
+
+// High constructs the `high` attribute. See attr.High.
+func High(value string) HighAttribute {
+	return HighAttribute{option{attr.High(value)}}
+}
+
+// HighInt constructs the `high` attribute from an int. See attr.HighInt.
+func HighInt(value int) HighAttribute {
+	return HighAttribute{option{attr.HighInt(value)}}
+}
+
+// HighFloat constructs the `high` attribute from a float64. See attr.HighFloat.
+func HighFloat(value float64) HighAttribute {
+	return HighAttribute{option{attr.HighFloat(value)}}
+}
+
+// HRefAttribute is the `href` attribute, which applies to <a>, <area>, <base>,
+// and <link>.
+type HRefAttribute struct{ option }
+
+func (HRefAttribute) aOption()    {}
+func (HRefAttribute) areaOption() {}
+func (HRefAttribute) baseOption() {}
+func (HRefAttribute) linkOption() {}
+
+// HRef constructs the `href` attribute. See attr.HRef.
+func HRef(value string) HRefAttribute {
+	return HRefAttribute{option{attr.HRef(value)}}
+}
+
+// HRefLangAttribute is the `hreflang` attribute, which applies to <a> and
+// <link>.
+type HRefLangAttribute struct{ option }
+
+func (HRefLangAttribute) aOption()    {}
+func (HRefLangAttribute) linkOption() {}
+
+// HRefLang constructs the `hreflang` attribute. See attr.HRefLang.
+func HRefLang(value string) HRefLangAttribute {
+	return HRefLangAttribute{option{attr.HRefLang(value)}}
+}
+
+// HttpEquivAttribute is the `http-equiv` attribute, which applies to <meta>.
+type HttpEquivAttribute struct{ option }
+
+func (HttpEquivAttribute) metaOption() {}
+
+// HttpEquiv constructs the `http-equiv` attribute. See attr.HttpEquiv.
+func HttpEquiv(value string) HttpEquivAttribute {
+	return HttpEquivAttribute{option{attr.HttpEquiv(value)}}
+}
+
+// HttpEquivKeyword constructs the `http-equiv` attribute from one of the
+// attr.HttpEquivValue constants. See attr.HttpEquivKeyword.
+func HttpEquivKeyword(value attr.HttpEquivValue) HttpEquivAttribute {
+	return HttpEquivAttribute{option{attr.HttpEquivKeyword(value)}}
+}
+
+// Id constructs the `id` attribute. See attr.Id.
+func Id(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Id(value)}}
+}
+
+// ImageSizesAttribute is the `imagesizes` attribute, which applies to <link>.
+type ImageSizesAttribute struct{ option }
+
+func (ImageSizesAttribute) linkOption() {}
+
+// ImageSizes constructs the `imagesizes` attribute. See attr.ImageSizes.
+func ImageSizes(value string) ImageSizesAttribute {
+	return ImageSizesAttribute{option{attr.ImageSizes(value)}}
+}
+
+// ImageSrcSetAttribute is the `imagesrcset` attribute, which applies to <link>.
+type ImageSrcSetAttribute struct{ option }
+
+func (ImageSrcSetAttribute) linkOption() {}
+
+// ImageSrcSet constructs the `imagesrcset` attribute. See attr.ImageSrcSet.
+func ImageSrcSet(value string) ImageSrcSetAttribute {
+	return ImageSrcSetAttribute{option{attr.ImageSrcSet(value)}}
+}
+
+// Inert constructs the `inert` attribute. See attr.Inert.
+func Inert() GlobalAttribute {
+	return GlobalAttribute{option{attr.Inert()}}
+}
+
+// InertIf constructs the `inert` attribute if condition is true. See attr.InertIf.
+func InertIf(condition bool) GlobalAttribute {
+	return GlobalAttribute{option{attr.InertIf(condition)}}
+}
+
+// InputMode constructs the `inputmode` attribute. See attr.InputMode.
+func InputMode(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.InputMode(value)}}
+}
+
+// InputModeKeyword constructs the `inputmode` attribute from one of the
+// attr.InputModeValue constants. See attr.InputModeKeyword.
+func InputModeKeyword(value attr.InputModeValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.InputModeKeyword(value)}}
+}
+
+// IntegrityAttribute is the `integrity` attribute, which applies to <link> and
+// <script>.
+type IntegrityAttribute struct{ option }
+
+func (IntegrityAttribute) linkOption()   {}
+func (IntegrityAttribute) scriptOption() {}
+
+// Integrity constructs the `integrity` attribute. See attr.Integrity.
+func Integrity(value string) IntegrityAttribute {
+	return IntegrityAttribute{option{attr.Integrity(value)}}
+}
+
+// Is constructs the `is` attribute. See attr.Is.
+func Is(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Is(value)}}
+}
+
+// IsMapAttribute is the `ismap` attribute, which applies to <img>.
+type IsMapAttribute struct{ option }
+
+func (IsMapAttribute) imgOption() {}
+
+// IsMap constructs the `ismap` attribute. See attr.IsMap.
+func IsMap() IsMapAttribute {
+	return IsMapAttribute{option{attr.IsMap()}}
+}
+
+// IsMapIf constructs the `ismap` attribute if condition is true. See attr.IsMapIf.
+func IsMapIf(condition bool) IsMapAttribute {
+	return IsMapAttribute{option{attr.IsMapIf(condition)}}
+}
+
+// ItemId constructs the `itemid` attribute. See attr.ItemId.
+func ItemId(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemId(value)}}
+}
+
+// ItemProp constructs the `itemprop` attribute. See attr.ItemProp.
+func ItemProp(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemProp(value)}}
+}
+
+// ItemRef constructs the `itemref` attribute. See attr.ItemRef.
+func ItemRef(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemRef(value)}}
+}
+
+// ItemScope constructs the `itemscope` attribute. See attr.ItemScope.
+func ItemScope() GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemScope()}}
+}
+
+// ItemScopeIf constructs the `itemscope` attribute if condition is true. See attr.ItemScopeIf.
+func ItemScopeIf(condition bool) GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemScopeIf(condition)}}
+}
+
+// ItemType constructs the `itemtype` attribute. See attr.ItemType.
+func ItemType(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.ItemType(value)}}
+}
+
+// KindAttribute is the `kind` attribute, which applies to <track>.
+type KindAttribute struct{ option }
+
+func (KindAttribute) trackOption() {}
+
+// Kind constructs the `kind` attribute. See attr.Kind.
+func Kind(value string) KindAttribute {
+	return KindAttribute{option{attr.Kind(value)}}
+}
+
+// KindKeyword constructs the `kind` attribute from one of the attr.KindValue
+// constants. See attr.KindKeyword.
+func KindKeyword(value attr.KindValue) KindAttribute {
+	return KindAttribute{option{attr.KindKeyword(value)}}
+}
+
+// LabelAttribute is the `label` attribute, which applies to <optgroup>,
+// <option>, and <track>.
+type LabelAttribute struct{ option }
+
+func (LabelAttribute) optgroupOption() {}
+func (LabelAttribute) optionOption()   {}
+func (LabelAttribute) trackOption()    {}
+
+// LabelAttr constructs the `label` attribute. See attr.Label.
+func LabelAttr(value string) LabelAttribute {
+	return LabelAttribute{option{attr.Label(value)}}
+}
+
+// Lang constructs the `lang` attribute. See attr.Lang.
+func Lang(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Lang(value)}}
+}
+
+// ListAttribute is the `list` attribute, which applies to <input>.
+type ListAttribute struct{ option }
+
+func (ListAttribute) inputOption() {}
+
+// List constructs the `list` attribute. See attr.List.
+func List(value string) ListAttribute {
+	return ListAttribute{option{attr.List(value)}}
+}
+
+// LoadingAttribute is the `loading` attribute, which applies to <iframe> and
+// <img>.
+type LoadingAttribute struct{ option }
+
+func (LoadingAttribute) iframeOption() {}
+func (LoadingAttribute) imgOption()    {}
+
+// Loading constructs the `loading` attribute. See attr.Loading.
+func Loading(value string) LoadingAttribute {
+	return LoadingAttribute{option{attr.Loading(value)}}
+}
+
+// LoadingKeyword constructs the `loading` attribute from one of the
+// attr.LoadingValue constants. See attr.LoadingKeyword.
+func LoadingKeyword(value attr.LoadingValue) LoadingAttribute {
+	return LoadingAttribute{option{attr.LoadingKeyword(value)}}
+}
+
+// LoopAttribute is the `loop` attribute, which applies to <audio> and <video>.
+type LoopAttribute struct{ option }
+
+func (LoopAttribute) audioOption() {}
+func (LoopAttribute) videoOption() {}
+
+// Loop constructs the `loop` attribute. See attr.Loop.
+func Loop() LoopAttribute {
+	return LoopAttribute{option{attr.Loop()}}
+}
+
+// LoopIf constructs the `loop` attribute if condition is true. See attr.LoopIf.
+func LoopIf(condition bool) LoopAttribute {
+	return LoopAttribute{option{attr.LoopIf(condition)}}
+}
+
+// LowAttribute is the `low` attribute, which applies to <meter>.
+type LowAttribute struct{ option }
+
+func (LowAttribute) meterOption() {}
+
+// Low constructs the `low` attribute. See attr.Low.
+func Low(value string) LowAttribute {
+	return LowAttribute{option{attr.Low(value)}}
+}
+
+// LowInt constructs the `low` attribute from an int. See attr.LowInt.
+func LowInt(value int) LowAttribute {
+	return LowAttribute{option{attr.LowInt(value)}}
+}
+
+// LowFloat constructs the `low` attribute from a float64. See attr.LowFloat.
+func LowFloat(value float64) LowAttribute {
+	return LowAttribute{option{attr.LowFloat(value)}}
+}
+
+// MaxAttribute is the `max` attribute, which applies to <input>, <meter>, and
+// <progress>.
+type MaxAttribute struct{ option }
+
+func (MaxAttribute) inputOption()    {}
+func (MaxAttribute) meterOption()    {}
+func (MaxAttribute) progressOption() {}
+
+// Max constructs the `max` attribute. See attr.Max.
+func Max(value string) MaxAttribute {
+	return MaxAttribute{option{attr.Max(value)}}
+}
+
+// MaxInt constructs the `max` attribute from an int. See attr.MaxInt.
+func MaxInt(value int) MaxAttribute {
+	return MaxAttribute{option{attr.MaxInt(value)}}
+}
+
+// MaxFloat constructs the `max` attribute from a float64. See attr.MaxFloat.
+func MaxFloat(value float64) MaxAttribute {
+	return MaxAttribute{option{attr.MaxFloat(value)}}
+}
+
+// MaxLengthAttribute is the `maxlength` attribute, which applies to <input> and
+// <textarea>.
+type MaxLengthAttribute struct{ option }
+
+func (MaxLengthAttribute) inputOption()    {}
+func (MaxLengthAttribute) textareaOption() {}
+
+// MaxLength constructs the `maxlength` attribute. See attr.MaxLength.
+func MaxLength(value string) MaxLengthAttribute {
+	return MaxLengthAttribute{option{attr.MaxLength(value)}}
+}
+
+// MaxLengthInt constructs the `maxlength` attribute from an int. See
+// attr.MaxLengthInt.
+func MaxLengthInt(value int) MaxLengthAttribute {
+	return MaxLengthAttribute{option{attr.MaxLengthInt(value)}}
+}
+
+// MediaAttribute is the `media` attribute, which applies to <link>, <meta>,
+// <source>, and <style>.
+type MediaAttribute struct{ option }
+
+func (MediaAttribute) linkOption()   {}
+func (MediaAttribute) metaOption()   {}
+func (MediaAttribute) sourceOption() {}
+func (MediaAttribute) styleOption()  {}
+
+// Media constructs the `media` attribute. See attr.Media.
+func Media(value string) MediaAttribute {
+	return MediaAttribute{option{attr.Media(value)}}
+}
+
+// MethodAttribute is the `method` attribute, which applies to <form>.
+type MethodAttribute struct{ option }
+
+func (MethodAttribute) formOption() {}
+
+// Method constructs the `method` attribute. See attr.Method.
+func Method(value string) MethodAttribute {
+	return MethodAttribute{option{attr.Method(value)}}
+}
+
+// MethodKeyword constructs the `method` attribute from one of the
+// attr.MethodValue constants. See attr.MethodKeyword.
+func MethodKeyword(value attr.MethodValue) MethodAttribute {
+	return MethodAttribute{option{attr.MethodKeyword(value)}}
+}
+
+// MinAttribute is the `min` attribute, which applies to <input> and <meter>.
+type MinAttribute struct{ option }
+
+func (MinAttribute) inputOption() {}
+func (MinAttribute) meterOption() {}
+
+// Min constructs the `min` attribute. See attr.Min.
+func Min(value string) MinAttribute {
+	return MinAttribute{option{attr.Min(value)}}
+}
+
+// MinInt constructs the `min` attribute from an int. See attr.MinInt.
+func MinInt(value int) MinAttribute {
+	return MinAttribute{option{attr.MinInt(value)}}
+}
+
+// MinFloat constructs the `min` attribute from a float64. See attr.MinFloat.
+func MinFloat(value float64) MinAttribute {
+	return MinAttribute{option{attr.MinFloat(value)}}
+}
+
+// MinLengthAttribute is the `minlength` attribute, which applies to <input> and
+// <textarea>.
+type MinLengthAttribute struct{ option }
+
+func (MinLengthAttribute) inputOption()    {}
+func (MinLengthAttribute) textareaOption() {}
+
+// MinLength constructs the `minlength` attribute. See attr.MinLength.
+func MinLength(value string) MinLengthAttribute {
+	return MinLengthAttribute{option{attr.MinLength(value)}}
+}
+
+// MinLengthInt constructs the `minlength` attribute from an int. See
+// attr.MinLengthInt.
+func MinLengthInt(value int) MinLengthAttribute {
+	return MinLengthAttribute{option{attr.MinLengthInt(value)}}
+}
+
+// MultipleAttribute is the `multiple` attribute, which applies to <input> and
+// <select>.
+type MultipleAttribute struct{ option }
+
+func (MultipleAttribute) inputOption()  {}
+func (MultipleAttribute) selectOption() {}
+
+// Multiple constructs the `multiple` attribute. See attr.Multiple.
+func Multiple() MultipleAttribute {
+	return MultipleAttribute{option{attr.Multiple()}}
+}
+
+// MultipleIf constructs the `multiple` attribute if condition is true. See attr.MultipleIf.
+func MultipleIf(condition bool) MultipleAttribute {
+	return MultipleAttribute{option{attr.MultipleIf(condition)}}
+}
+
+// MutedAttribute is the `muted` attribute, which applies to <audio> and
+// <video>.
+type MutedAttribute struct{ option }
+
+func (MutedAttribute) audioOption() {}
+func (MutedAttribute) videoOption() {}
+
+// Muted constructs the `muted` attribute. See attr.Muted.
+func Muted() MutedAttribute {
+	return MutedAttribute{option{attr.Muted()}}
+}
+
+// MutedIf constructs the `muted` attribute if condition is true. See attr.MutedIf.
+func MutedIf(condition bool) MutedAttribute {
+	return MutedAttribute{option{attr.MutedIf(condition)}}
+}
+
+// NameAttribute is the `name` attribute, which applies to <button>, <details>,
+// <fieldset>, <form>, <iframe>, <input>, <map>, <meta>, <object>, <output>,
+// <select>, <slot>, and <textarea>.
+type NameAttribute struct{ option }
+
+func (NameAttribute) buttonOption()   {}
+func (NameAttribute) detailsOption()  {}
+func (NameAttribute) fieldsetOption() {}
+func (NameAttribute) formOption()     {}
+func (NameAttribute) iframeOption()   {}
+func (NameAttribute) inputOption()    {}
+func (NameAttribute) mapOption()      {}
+func (NameAttribute) metaOption()     {}
+func (NameAttribute) objectOption()   {}
+func (NameAttribute) outputOption()   {}
+func (NameAttribute) selectOption()   {}
+func (NameAttribute) slotOption()     {}
+func (NameAttribute) textareaOption() {}
+
+// Name constructs the `name` attribute. See attr.Name.
+func Name(value string) NameAttribute {
+	return NameAttribute{option{attr.Name(value)}}
+}
+
+// NoModuleAttribute is the `nomodule` attribute, which applies to <script>.
+type NoModuleAttribute struct{ option }
+
+func (NoModuleAttribute) scriptOption() {}
+
+// NoModule constructs the `nomodule` attribute. See attr.NoModule.
+func NoModule() NoModuleAttribute {
+	return NoModuleAttribute{option{attr.NoModule()}}
+}
+
+// NoModuleIf constructs the `nomodule` attribute if condition is true. See attr.NoModuleIf.
+func NoModuleIf(condition bool) NoModuleAttribute {
+	return NoModuleAttribute{option{attr.NoModuleIf(condition)}}
+}
+
+// Nonce constructs the `nonce` attribute. See attr.Nonce.
+func Nonce(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Nonce(value)}}
+}
+
+// NoValidateAttribute is the `novalidate` attribute, which applies to <form>.
+type NoValidateAttribute struct{ option }
+
+func (NoValidateAttribute) formOption() {}
+
+// NoValidate constructs the `novalidate` attribute. See attr.NoValidate.
+func NoValidate() NoValidateAttribute {
+	return NoValidateAttribute{option{attr.NoValidate()}}
+}
+
+// NoValidateIf constructs the `novalidate` attribute if condition is true. See attr.NoValidateIf.
+func NoValidateIf(condition bool) NoValidateAttribute {
+	return NoValidateAttribute{option{attr.NoValidateIf(condition)}}
+}
+
+// OpenAttribute is the `open` attribute, which applies to <details> and
+// <dialog>.
+type OpenAttribute struct{ option }
+
+func (OpenAttribute) detailsOption() {}
+func (OpenAttribute) dialogOption()  {}
+
+// Open constructs the `open` attribute. See attr.Open.
+func Open() OpenAttribute {
+	return OpenAttribute{option{attr.Open()}}
+}
+
+// OpenIf constructs the `open` attribute if condition is true. See attr.OpenIf.
+func OpenIf(condition bool) OpenAttribute {
+	return OpenAttribute{option{attr.OpenIf(condition)}}
+}
+
+// OptimumAttribute is the `optimum` attribute, which applies to <meter>.
+type OptimumAttribute struct{ option }
+
+func (OptimumAttribute) meterOption() {}
+
+// Optimum constructs the `optimum` attribute. See attr.Optimum.
+func Optimum(value string) OptimumAttribute {
+	return OptimumAttribute{option{attr.Optimum(value)}}
+}
+
+// OptimumInt constructs the `optimum` attribute from an int. See
+// attr.OptimumInt.
+func OptimumInt(value int) OptimumAttribute {
+	return OptimumAttribute{option{attr.OptimumInt(value)}}
+}
+
+// OptimumFloat constructs the `optimum` attribute from a float64. See
+// attr.OptimumFloat.
+func OptimumFloat(value float64) OptimumAttribute {
+	return OptimumAttribute{option{attr.OptimumFloat(value)}}
+}
+
+// PatternAttribute is the `pattern` attribute, which applies to <input>.
+type PatternAttribute struct{ option }
+
+func (PatternAttribute) inputOption() {}
+
+// Pattern constructs the `pattern` attribute. See attr.Pattern.
+func Pattern(value string) PatternAttribute {
+	return PatternAttribute{option{attr.Pattern(value)}}
+}
+
+// PingAttribute is the `ping` attribute, which applies to <a> and <area>.
+type PingAttribute struct{ option }
+
+func (PingAttribute) aOption()    {}
+func (PingAttribute) areaOption() {}
+
+// Ping constructs the `ping` attribute. See attr.Ping.
+func Ping(value string) PingAttribute {
+	return PingAttribute{option{attr.Ping(value)}}
+}
+
+// PlaceholderAttribute is the `placeholder` attribute, which applies to <input>
+// and <textarea>.
+type PlaceholderAttribute struct{ option }
+
+func (PlaceholderAttribute) inputOption()    {}
+func (PlaceholderAttribute) textareaOption() {}
+
+// Placeholder constructs the `placeholder` attribute. See attr.Placeholder.
+func Placeholder(value string) PlaceholderAttribute {
+	return PlaceholderAttribute{option{attr.Placeholder(value)}}
+}
+
+// PlaysInlineAttribute is the `playsinline` attribute, which applies to
+// <video>.
+type PlaysInlineAttribute struct{ option }
+
+func (PlaysInlineAttribute) videoOption() {}
+
+// PlaysInline constructs the `playsinline` attribute. See attr.PlaysInline.
+func PlaysInline() PlaysInlineAttribute {
+	return PlaysInlineAttribute{option{attr.PlaysInline()}}
+}
+
+// PlaysInlineIf constructs the `playsinline` attribute if condition is true. See attr.PlaysInlineIf.
+func PlaysInlineIf(condition bool) PlaysInlineAttribute {
+	return PlaysInlineAttribute{option{attr.PlaysInlineIf(condition)}}
+}
+
+// PopOver constructs the `popover` attribute. See attr.PopOver.
+func PopOver(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.PopOver(value)}}
+}
+
+// PopOverKeyword constructs the `popover` attribute from one of the
+// attr.PopOverValue constants. See attr.PopOverKeyword.
+func PopOverKeyword(value attr.PopOverValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.PopOverKeyword(value)}}
+}
+
+// PopOverTargetAttribute is the `popovertarget` attribute, which applies to
+// <button> and <input>.
+type PopOverTargetAttribute struct{ option }
+
+func (PopOverTargetAttribute) buttonOption() {}
+func (PopOverTargetAttribute) inputOption()  {}
+
+// PopOverTarget constructs the `popovertarget` attribute. See attr.PopOverTarget.
+func PopOverTarget(value string) PopOverTargetAttribute {
+	return PopOverTargetAttribute{option{attr.PopOverTarget(value)}}
+}
+
+// PopOverTargetActionAttribute is the `popovertargetaction` attribute, which
+// applies to <button> and <input>.
+type PopOverTargetActionAttribute struct{ option }
+
+func (PopOverTargetActionAttribute) buttonOption() {}
+func (PopOverTargetActionAttribute) inputOption()  {}
+
+// PopOverTargetAction constructs the `popovertargetaction` attribute. See attr.PopOverTargetAction.
+func PopOverTargetAction(value string) PopOverTargetActionAttribute {
+	return PopOverTargetActionAttribute{option{attr.PopOverTargetAction(value)}}
+}
+
+// PopOverTargetActionKeyword constructs the `popovertargetaction` attribute
+// from one of the attr.PopOverTargetActionValue constants. See
+// attr.PopOverTargetActionKeyword.
+func PopOverTargetActionKeyword(value attr.PopOverTargetActionValue) PopOverTargetActionAttribute {
+	return PopOverTargetActionAttribute{option{attr.PopOverTargetActionKeyword(value)}}
+}
+
+// PosterAttribute is the `poster` attribute, which applies to <video>.
+type PosterAttribute struct{ option }
+
+func (PosterAttribute) videoOption() {}
+
+// Poster constructs the `poster` attribute. See attr.Poster.
+func Poster(value string) PosterAttribute {
+	return PosterAttribute{option{attr.Poster(value)}}
+}
+
+// PreLoadAttribute is the `preload` attribute, which applies to <audio> and
+// <video>.
+type PreLoadAttribute struct{ option }
+
+func (PreLoadAttribute) audioOption() {}
+func (PreLoadAttribute) videoOption() {}
+
+// PreLoad constructs the `preload` attribute. See attr.PreLoad.
+func PreLoad(value string) PreLoadAttribute {
+	return PreLoadAttribute{option{attr.PreLoad(value)}}
+}
+
+// PreLoadKeyword constructs the `preload` attribute from one of the
+// attr.PreloadValue constants. See attr.PreLoadKeyword.
+func PreLoadKeyword(value attr.PreloadValue) PreLoadAttribute {
+	return PreLoadAttribute{option{attr.PreLoadKeyword(value)}}
+}
+
+// ReadOnlyAttribute is the `readonly` attribute, which applies to <input> and
+// <textarea>.
+type ReadOnlyAttribute struct{ option }
+
+func (ReadOnlyAttribute) inputOption()    {}
+func (ReadOnlyAttribute) textareaOption() {}
+
+// ReadOnly constructs the `readonly` attribute. See attr.ReadOnly.
+func ReadOnly() ReadOnlyAttribute {
+	return ReadOnlyAttribute{option{attr.ReadOnly()}}
+}
+
+// ReadOnlyIf constructs the `readonly` attribute if condition is true. See attr.ReadOnlyIf.
+func ReadOnlyIf(condition bool) ReadOnlyAttribute {
+	return ReadOnlyAttribute{option{attr.ReadOnlyIf(condition)}}
+}
+
+// ReferrerPolicyAttribute is the `referrerpolicy` attribute, which applies to
+// <a>, <area>, <iframe>, <img>, <link>, and <script>.
+type ReferrerPolicyAttribute struct{ option }
+
+func (ReferrerPolicyAttribute) aOption()      {}
+func (ReferrerPolicyAttribute) areaOption()   {}
+func (ReferrerPolicyAttribute) iframeOption() {}
+func (ReferrerPolicyAttribute) imgOption()    {}
+func (ReferrerPolicyAttribute) linkOption()   {}
+func (ReferrerPolicyAttribute) scriptOption() {}
+
+// ReferrerPolicy constructs the `referrerpolicy` attribute. See attr.ReferrerPolicy.
+func ReferrerPolicy(value string) ReferrerPolicyAttribute {
+	return ReferrerPolicyAttribute{option{attr.ReferrerPolicy(value)}}
+}
+
+// ReferrerPolicyKeyword constructs the `referrerpolicy` attribute from one of
+// the attr.ReferrerPolicyValue constants. See attr.ReferrerPolicyKeyword.
+func ReferrerPolicyKeyword(value attr.ReferrerPolicyValue) ReferrerPolicyAttribute {
+	return ReferrerPolicyAttribute{option{attr.ReferrerPolicyKeyword(value)}}
+}
+
+// RelAttribute is the `rel` attribute, which applies to <a>, <area>, <form>,
+// and <link>.
+type RelAttribute struct{ option }
+
+func (RelAttribute) aOption()    {}
+func (RelAttribute) areaOption() {}
+func (RelAttribute) formOption() {}
+func (RelAttribute) linkOption() {}
+
+// Rel constructs the `rel` attribute. See attr.Rel.
+func Rel(value string) RelAttribute {
+	return RelAttribute{option{attr.Rel(value)}}
+}
+
+// RelKeywords constructs the `rel` attribute from attr.RelValue constants,
+// which are joined by spaces. See attr.RelKeywords.
+func RelKeywords(values ...attr.RelValue) RelAttribute {
+	return RelAttribute{option{attr.RelKeywords(values...)}}
+}
+
+// RequiredAttribute is the `required` attribute, which applies to <input>,
+// <select>, and <textarea>.
+type RequiredAttribute struct{ option }
+
+func (RequiredAttribute) inputOption()    {}
+func (RequiredAttribute) selectOption()   {}
+func (RequiredAttribute) textareaOption() {}
+
+// Required constructs the `required` attribute. See attr.Required.
+func Required() RequiredAttribute {
+	return RequiredAttribute{option{attr.Required()}}
+}
+
+// RequiredIf constructs the `required` attribute if condition is true. See attr.RequiredIf.
+func RequiredIf(condition bool) RequiredAttribute {
+	return RequiredAttribute{option{attr.RequiredIf(condition)}}
+}
+
+// ReversedAttribute is the `reversed` attribute, which applies to <ol>.
+type ReversedAttribute struct{ option }
+
+func (ReversedAttribute) olOption() {}
+
+// Reversed constructs the `reversed` attribute. See attr.Reversed.
+func Reversed() ReversedAttribute {
+	return ReversedAttribute{option{attr.Reversed()}}
+}
+
+// ReversedIf constructs the `reversed` attribute if condition is true. See attr.ReversedIf.
+func ReversedIf(condition bool) ReversedAttribute {
+	return ReversedAttribute{option{attr.ReversedIf(condition)}}
+}
+
+// RowsAttribute is the `rows` attribute, which applies to <textarea>.
+type RowsAttribute struct{ option }
+
+func (RowsAttribute) textareaOption() {}
+
+// Rows constructs the `rows` attribute. See attr.Rows.
+func Rows(value string) RowsAttribute {
+	return RowsAttribute{option{attr.Rows(value)}}
+}
+
+// RowsInt constructs the `rows` attribute from an int. See attr.RowsInt.
+func RowsInt(value int) RowsAttribute {
+	return RowsAttribute{option{attr.RowsInt(value)}}
+}
+
+// RowSpanAttribute is the `rowspan` attribute, which applies to <td> and <th>.
+type RowSpanAttribute struct{ option }
+
+func (RowSpanAttribute) tdOption() {}
+func (RowSpanAttribute) thOption() {}
+
+// RowSpan constructs the `rowspan` attribute. See attr.RowSpan.
+func RowSpan(value string) RowSpanAttribute {
+	return RowSpanAttribute{option{attr.RowSpan(value)}}
+}
+
+// RowSpanInt constructs the `rowspan` attribute from an int. See
+// attr.RowSpanInt.
+func RowSpanInt(value int) RowSpanAttribute {
+	return RowSpanAttribute{option{attr.RowSpanInt(value)}}
+}
+
+// SandboxAttribute is the `sandbox` attribute, which applies to <iframe>.
+type SandboxAttribute struct{ option }
+
+func (SandboxAttribute) iframeOption() {}
+
+// Sandbox constructs the `sandbox` attribute. See attr.Sandbox.
+func Sandbox(value string) SandboxAttribute {
+	return SandboxAttribute{option{attr.Sandbox(value)}}
+}
+
+// SandboxKeywords constructs the `sandbox` attribute from attr.SandboxValue
+// constants, which are joined by spaces. See attr.SandboxKeywords.
+func SandboxKeywords(values ...attr.SandboxValue) SandboxAttribute {
+	return SandboxAttribute{option{attr.SandboxKeywords(values...)}}
+}
+
+// ScopeAttribute is the `scope` attribute, which applies to <th>.
+type ScopeAttribute struct{ option }
+
+func (ScopeAttribute) thOption() {}
+
+// Scope constructs the `scope` attribute. See attr.Scope.
+func Scope(value string) ScopeAttribute {
+	return ScopeAttribute{option{attr.Scope(value)}}
+}
+
+// ScopeKeyword constructs the `scope` attribute from one of the attr.ScopeValue
+// constants. See attr.ScopeKeyword.
+func ScopeKeyword(value attr.ScopeValue) ScopeAttribute {
+	return ScopeAttribute{option{attr.ScopeKeyword(value)}}
+}
+
+// SelectedAttribute is the `selected` attribute, which applies to <option>.
+type SelectedAttribute struct{ option }
+
+func (SelectedAttribute) optionOption() {}
+
+// Selected constructs the `selected` attribute. See attr.Selected.
+func Selected() SelectedAttribute {
+	return SelectedAttribute{option{attr.Selected()}}
+}
+
+// SelectedIf constructs the `selected` attribute if condition is true. See attr.SelectedIf.
+func SelectedIf(condition bool) SelectedAttribute {
+	return SelectedAttribute{option{attr.SelectedIf(condition)}}
+}
+
+// ShapeAttribute is the `shape` attribute, which applies to <area>.
+type ShapeAttribute struct{ option }
+
+func (ShapeAttribute) areaOption() {}
+
+// Shape constructs the `shape` attribute. See attr.Shape.
+func Shape(value string) ShapeAttribute {
+	return ShapeAttribute{option{attr.Shape(value)}}
+}
+
+// ShapeKeyword constructs the `shape` attribute from one of the attr.ShapeValue
+// constants. See attr.ShapeKeyword.
+func ShapeKeyword(value attr.ShapeValue) ShapeAttribute {
+	return ShapeAttribute{option{attr.ShapeKeyword(value)}}
+}
+
+// SizeAttribute is the `size` attribute, which applies to <input> and <select>.
+type SizeAttribute struct{ option }
+
+func (SizeAttribute) inputOption()  {}
+func (SizeAttribute) selectOption() {}
+
+// Size constructs the `size` attribute. See attr.Size.
+func Size(value string) SizeAttribute {
+	return SizeAttribute{option{attr.Size(value)}}
+}
+
+// SizeInt constructs the `size` attribute from an int. See attr.SizeInt.
+func SizeInt(value int) SizeAttribute {
+	return SizeAttribute{option{attr.SizeInt(value)}}
+}
+
+// SizesAttribute is the `sizes` attribute, which applies to <img>, <link>, and
+// <source>.
+type SizesAttribute struct{ option }
+
+func (SizesAttribute) imgOption()    {}
+func (SizesAttribute) linkOption()   {}
+func (SizesAttribute) sourceOption() {}
+
+// Sizes constructs the `sizes` attribute. See attr.Sizes.
+func Sizes(value string) SizesAttribute {
+	return SizesAttribute{option{attr.Sizes(value)}}
+}
+
+// SlotAttr constructs the `slot` attribute. See attr.Slot.
+func SlotAttr(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Slot(value)}}
+}
+
+// SpanAttribute is the `span` attribute, which applies to <col> and <colgroup>.
+type SpanAttribute struct{ option }
+
+func (SpanAttribute) colOption()      {}
+func (SpanAttribute) colgroupOption() {}
+
+// SpanAttr constructs the `span` attribute. See attr.Span.
+func SpanAttr(value string) SpanAttribute {
+	return SpanAttribute{option{attr.Span(value)}}
+}
+
+// SpanAttrInt constructs the `span` attribute from an int. See attr.SpanInt.
+func SpanAttrInt(value int) SpanAttribute {
+	return SpanAttribute{option{attr.SpanInt(value)}}
+}
+
+// SpellCheck constructs the `spellcheck` attribute. See attr.SpellCheck.
+func SpellCheck(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.SpellCheck(value)}}
+}
+
+// SpellCheckKeyword constructs the `spellcheck` attribute from one of the
+// attr.SpellCheckValue constants. See attr.SpellCheckKeyword.
+func SpellCheckKeyword(value attr.SpellCheckValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.SpellCheckKeyword(value)}}
+}
+
+// SrcAttribute is the `src` attribute, which applies to <audio>, <embed>,
+// <iframe>, <img>, <input>, <script>, <source>, <track>, and <video>.
+type SrcAttribute struct{ option }
+
+func (SrcAttribute) audioOption()  {}
+func (SrcAttribute) embedOption()  {}
+func (SrcAttribute) iframeOption() {}
+func (SrcAttribute) imgOption()    {}
+func (SrcAttribute) inputOption()  {}
+func (SrcAttribute) scriptOption() {}
+func (SrcAttribute) sourceOption() {}
+func (SrcAttribute) trackOption()  {}
+func (SrcAttribute) videoOption()  {}
+
+// Src constructs the `src` attribute. See attr.Src.
+func Src(value string) SrcAttribute {
+	return SrcAttribute{option{attr.Src(value)}}
+}
+
+// SrcDocAttribute is the `srcdoc` attribute, which applies to <iframe>.
+type SrcDocAttribute struct{ option }
+
+func (SrcDocAttribute) iframeOption() {}
+
+// SrcDoc constructs the `srcdoc` attribute. See attr.SrcDoc.
+func SrcDoc(value string) SrcDocAttribute {
+	return SrcDocAttribute{option{attr.SrcDoc(value)}}
+}
+
+// SrcLangAttribute is the `srclang` attribute, which applies to <track>.
+type SrcLangAttribute struct{ option }
+
+func (SrcLangAttribute) trackOption() {}
+
+// SrcLang constructs the `srclang` attribute. See attr.SrcLang.
+func SrcLang(value string) SrcLangAttribute {
+	return SrcLangAttribute{option{attr.SrcLang(value)}}
+}
+
+// SrcSetAttribute is the `srcset` attribute, which applies to <img> and
+// <source>.
+type SrcSetAttribute struct{ option }
+
+func (SrcSetAttribute) imgOption()    {}
+func (SrcSetAttribute) sourceOption() {}
+
+// SrcSet constructs the `srcset` attribute. See attr.SrcSet.
+func SrcSet(value string) SrcSetAttribute {
+	return SrcSetAttribute{option{attr.SrcSet(value)}}
+}
+
+// StartAttribute is the `start` attribute, which applies to <ol>.
+type StartAttribute struct{ option }
+
+func (StartAttribute) olOption() {}
+
+// Start constructs the `start` attribute. See attr.Start.
+func Start(value string) StartAttribute {
+	return StartAttribute{option{attr.Start(value)}}
+}
+
+// StartInt constructs the `start` attribute from an int. See attr.StartInt.
+func StartInt(value int) StartAttribute {
+	return StartAttribute{option{attr.StartInt(value)}}
+}
+
+// StepAttribute is the `step` attribute, which applies to <input>.
+type StepAttribute struct{ option }
+
+func (StepAttribute) inputOption() {}
+
+// Step constructs the `step` attribute. See attr.Step.
+func Step(value string) StepAttribute {
+	return StepAttribute{option{attr.Step(value)}}
+}
+
+// StepInt constructs the `step` attribute from an int. See attr.StepInt.
+func StepInt(value int) StepAttribute {
+	return StepAttribute{option{attr.StepInt(value)}}
+}
+
+// StepFloat constructs the `step` attribute from a float64. See attr.StepFloat.
+func StepFloat(value float64) StepAttribute {
+	return StepAttribute{option{attr.StepFloat(value)}}
+}
+
+// StyleAttr constructs the `style` attribute. See attr.Style.
+func StyleAttr(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Style(value)}}
+}
+
+// TabIndex constructs the `tabindex` attribute. See attr.TabIndex.
+func TabIndex(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.TabIndex(value)}}
+}
+
+// TabIndexInt constructs the `tabindex` attribute from an int. See
+// attr.TabIndexInt.
+func TabIndexInt(value int) GlobalAttribute {
+	return GlobalAttribute{option{attr.TabIndexInt(value)}}
+}
+
+// TargetAttribute is the `target` attribute, which applies to <a>, <area>,
+// <base>, and <form>.
+type TargetAttribute struct{ option }
+
+func (TargetAttribute) aOption()    {}
+func (TargetAttribute) areaOption() {}
+func (TargetAttribute) baseOption() {}
+func (TargetAttribute) formOption() {}
+
+// Target constructs the `target` attribute. See attr.Target.
+func Target(value string) TargetAttribute {
+	return TargetAttribute{option{attr.Target(value)}}
+}
+
+// TargetKeyword constructs the `target` attribute from one of the
+// attr.TargetValue constants. See attr.TargetKeyword.
+func TargetKeyword(value attr.TargetValue) TargetAttribute {
+	return TargetAttribute{option{attr.TargetKeyword(value)}}
+}
+
+// TitleAttr constructs the `title` attribute. See attr.Title.
+func TitleAttr(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Title(value)}}
+}
+
+// Translate constructs the `translate` attribute. See attr.Translate.
+func Translate(value string) GlobalAttribute {
+	return GlobalAttribute{option{attr.Translate(value)}}
+}
+
+// TranslateKeyword constructs the `translate` attribute from one of the
+// attr.TranslateValue constants. See attr.TranslateKeyword.
+func TranslateKeyword(value attr.TranslateValue) GlobalAttribute {
+	return GlobalAttribute{option{attr.TranslateKeyword(value)}}
+}
+
+// TypeAttribute is the `type` attribute, which applies to <a>, <button>,
+// <embed>, <input>, <link>, <object>, <ol>, <script>, and <source>.
+type TypeAttribute struct{ option }
+
+func (TypeAttribute) aOption()      {}
+func (TypeAttribute) buttonOption() {}
+func (TypeAttribute) embedOption()  {}
+func (TypeAttribute) inputOption()  {}
+func (TypeAttribute) linkOption()   {}
+func (TypeAttribute) objectOption() {}
+func (TypeAttribute) olOption()     {}
+func (TypeAttribute) scriptOption() {}
+func (TypeAttribute) sourceOption() {}
+
+// Type constructs the `type` attribute. See attr.Type.
+func Type(value string) TypeAttribute {
+	return TypeAttribute{option{attr.Type(value)}}
+}
+
+// TypeKeyword constructs the `type` attribute from one of the
+// attr.InputTypeValue, attr.ButtonTypeValue, attr.ScriptTypeValue or
+// attr.ListTypeValue constants. See attr.TypeKeyword.
+func TypeKeyword[T attr.InputTypeValue | attr.ButtonTypeValue | attr.ScriptTypeValue | attr.ListTypeValue](value T) TypeAttribute {
+	return TypeAttribute{option{attr.TypeKeyword(value)}}
+}
+
+// UseMapAttribute is the `usemap` attribute, which applies to <img>.
+type UseMapAttribute struct{ option }
+
+func (UseMapAttribute) imgOption() {}
+
+// UseMap constructs the `usemap` attribute. See attr.UseMap.
+func UseMap(value string) UseMapAttribute {
+	return UseMapAttribute{option{attr.UseMap(value)}}
+}
+
+// ValueAttribute is the `value` attribute, which applies to <button>, <data>,
+// <input>, <li>, <meter>, <option>, <output>, and <progress>.
+type ValueAttribute struct{ option }
+
+func (ValueAttribute) buttonOption()   {}
+func (ValueAttribute) dataOption()     {}
+func (ValueAttribute) inputOption()    {}
+func (ValueAttribute) liOption()       {}
+func (ValueAttribute) meterOption()    {}
+func (ValueAttribute) optionOption()   {}
+func (ValueAttribute) outputOption()   {}
+func (ValueAttribute) progressOption() {}
+
+// Value constructs the `value` attribute. See attr.Value.
+func Value(value string) ValueAttribute {
+	return ValueAttribute{option{attr.Value(value)}}
+}
+
+// WidthAttribute is the `width` attribute, which applies to <canvas>, <embed>,
+// <iframe>, <img>, <input>, <object>, <source>, and <video>.
+type WidthAttribute struct{ option }
+
+func (WidthAttribute) canvasOption() {}
+func (WidthAttribute) embedOption()  {}
+func (WidthAttribute) iframeOption() {}
+func (WidthAttribute) imgOption()    {}
+func (WidthAttribute) inputOption()  {}
+func (WidthAttribute) objectOption() {}
+func (WidthAttribute) sourceOption() {}
+func (WidthAttribute) videoOption()  {}
+
+// Width constructs the `width` attribute. See attr.Width.
+func Width(value string) WidthAttribute {
+	return WidthAttribute{option{attr.Width(value)}}
+}
+
+// WidthInt constructs the `width` attribute from an int. See attr.WidthInt.
+func WidthInt(value int) WidthAttribute {
+	return WidthAttribute{option{attr.WidthInt(value)}}
+}
+
+// WrapAttribute is the `wrap` attribute, which applies to <textarea>.
+type WrapAttribute struct{ option }
+
+func (WrapAttribute) textareaOption() {}
+
+// Wrap constructs the `wrap` attribute. See attr.Wrap.
+func Wrap(value string) WrapAttribute {
+	return WrapAttribute{option{attr.Wrap(value)}}
+}
+
+// WrapKeyword constructs the `wrap` attribute from one of the attr.WrapValue
+// constants. See attr.WrapKeyword.
+func WrapKeyword(value attr.WrapValue) WrapAttribute {
+	return WrapAttribute{option{attr.WrapKeyword(value)}}
+}
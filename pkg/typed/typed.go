@@ -0,0 +1,78 @@
+// Package typed is an opt-in API that checks at compile time that attributes
+// are used on elements they apply to. It mirrors pkg/tag and pkg/attr, but
+// every element accepts its own option type, like InputOption, and each
+// attribute only implements the option types of the elements it applies to.
+// So typed.Div(typed.HRef("/x")) does not compile.
+//
+// Elements return a plain html.Node, so typed and untyped code mix freely.
+// Children wraps content, including elements built with pkg/tag or this
+// package, and Attributes wraps attributes that are not checked, like `data-*`
+// attributes, ARIA attributes, and event handlers.
+//
+// Example Usage:
+//
+//	typed.A(
+//		typed.HRef("/docs"),
+//		typed.Class("nav-link"),
+//		typed.Attributes(aria.Current(aria.CurrentPage)),
+//		typed.Text("Docs"),
+//	)
+//
+// Attributes that share their name with an element have an Attr suffix, like
+// typed.TitleAttr and typed.Title. Obsolete elements are not included.
+//
+// Options are interfaces, so each option costs an allocation that the
+// equivalent pkg/tag code does not. Prefer pkg/tag in hot loops.
+package typed
+
+import "github.com/jeffswenson/sanity/pkg/html"
+
+// option is embedded in every option type. It holds the node the option
+// renders as.
+type option struct {
+	n html.Node
+}
+
+func (o option) node() html.Node {
+	return o.n
+}
+
+// Child is the content of an element, like text or child elements. It is
+// an option of every element that has children.
+type Child struct{ option }
+
+// Children wraps nodes as the content of an element. The nodes are not
+// checked, so they may also contain attributes.
+//
+// Example Usage:
+// typed.UL(typed.Children(html.ForEach(items, itemView)))
+func Children(nodes ...html.Node) Child {
+	return Child{option{html.Combine(nodes...)}}
+}
+
+// Text is content that is escaped and rendered as text. See html.InnerText.
+func Text(text string) Child {
+	return Child{option{html.InnerText(text)}}
+}
+
+// GlobalAttribute is an attribute that applies to every element.
+type GlobalAttribute struct{ option }
+
+// Attributes wraps nodes as attributes that are not checked, like the
+// attributes built by attr.DataAttr, pkg/aria, and pkg/event. It is an option
+// of every element.
+//
+// Example Usage:
+// typed.Button(typed.Attributes(event.OnClick(js.Call("save"))), typed.Text("Save"))
+func Attributes(nodes ...html.Node) GlobalAttribute {
+	return GlobalAttribute{option{html.Combine(nodes...)}}
+}
+
+// nodes converts options into the children of an html.Node.
+func nodes[T interface{ node() html.Node }](options []T) []html.Node {
+	result := make([]html.Node, len(options))
+	for i, option := range options {
+		result[i] = option.node()
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jeffswenson/sanity/pkg/aria"
+	"github.com/jeffswenson/sanity/pkg/attr"
+	"github.com/jeffswenson/sanity/pkg/html"
+	"github.com/jeffswenson/sanity/pkg/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	require.Equal(t,
+		`<a href="/docs" class="nav-link" aria-current="page">Docs &amp; guides</a>`,
+		A(
+			HRef("/docs"),
+			Class("nav-link"),
+			Attributes(aria.Current(aria.CurrentPage)),
+			Text("Docs & guides"),
+		).String())
+
+	require.Equal(t,
+		`<input type="checkbox" name="done" checked>`,
+		Input(TypeKeyword(attr.InputTypeCheckbox), Name("done"), CheckedIf(true), DisabledIf(false)).String())
+
+	require.Equal(t,
+		`<td colspan="2" title="Total">42</td>`,
+		TD(ColSpanInt(2), TitleAttr("Total"), Text("42")).String())
+}
+
+func TestInterop(t *testing.T) {
+	// Typed elements are html.Nodes and untyped nodes are passed with
+	// Children.
+	require.Equal(t,
+		`<div><ul class="list"><li>a</li><li>b</li></ul></div>`,
+		tag.Div(
+			UL(Class("list"), Children(
+				html.ForEach([]string{"a", "b"}, func(item string) html.Node {
+					return tag.LI(html.InnerText(item))
+				}),
+			)),
+		).String())
+}
+
+func TestOptionSets(t *testing.T) {
+	implements := func(value any, option any) bool {
+		return reflect.TypeOf(value).Implements(reflect.TypeOf(option).Elem())
+	}
+	require.True(t, implements(HRef("/"), (*AOption)(nil)))
+	require.True(t, implements(HRef("/"), (*LinkOption)(nil)))
+	require.False(t, implements(HRef("/"), (*DivOption)(nil)))
+	require.False(t, implements(Rows("3"), (*AOption)(nil)))
+	require.True(t, implements(Rows("3"), (*TextAreaOption)(nil)))
+	require.True(t, implements(Class("x"), (*InputOption)(nil)))
+	require.True(t, implements(Text("x"), (*DivOption)(nil)))
+	require.False(t, implements(Text("x"), (*InputOption)(nil)))
+	require.False(t, implements(Children(), (*BrOption)(nil)))
+	require.True(t, implements(Attributes(), (*BrOption)(nil)))
+}